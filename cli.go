@@ -0,0 +1,129 @@
+package main
+
+import (
+	"career-log-be/config/database"
+	"career-log-be/services/job_satisfaction/core/replay"
+	chat_scheduler "career-log-be/services/note/chat/scheduler"
+	"career-log-be/services/usage/core/ledger"
+	"career-log-be/utils/llm"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+)
+
+// runCommand는 서버 대신 실행할 관리 명령을 처리합니다. 처리한 명령이 있으면 true를 반환합니다
+func runCommand(args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	switch args[0] {
+	case "replay":
+		return true, runReplayCommand(args[1:])
+	case "backfill":
+		return true, runBackfillCommand(args[1:])
+	default:
+		return true, fmt.Errorf("unknown command: %s", args[0])
+	}
+}
+
+// runReplayCommand는 이벤트 로그로 직무 만족도를 재계산하고 결과를 JSON으로 출력합니다
+func runReplayCommand(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	userID := flags.String("user", "", "재계산할 사용자 ID (비어 있으면 전체 사용자)")
+	dryRun := flags.Bool("dry-run", false, "저장하지 않고 저장된 값과의 차이만 출력")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := openCommandDatabase()
+	if err != nil {
+		return err
+	}
+
+	report, err := replay.Run(db, replay.Options{UserID: *userID, DryRun: *dryRun})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(report)
+}
+
+// runBackfillCommand는 지정한 기간의 분석되지 않은 대화를 분석하고 실행 기록을 JSON으로 출력합니다
+func runBackfillCommand(args []string) error {
+	flags := flag.NewFlagSet("backfill", flag.ContinueOnError)
+	from := flags.String("from", "", "시작 날짜 (YYYY-MM-DD, 포함)")
+	to := flags.String("to", "", "종료 날짜 (YYYY-MM-DD, 포함)")
+	userID := flags.String("user", "", "분석할 사용자 ID (비어 있으면 전체 사용자)")
+	reanalyze := flags.Bool("reanalyze", false, "이미 분석한 대화도 다시 분석하여 이전 결과를 대체")
+	resume := flags.String("resume", "", "이어서 실행할 중단된 백필 실행 ID (지정하면 다른 옵션은 무시)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := openCommandDatabase()
+	if err != nil {
+		return err
+	}
+
+	options := &chat_scheduler.BackfillOptions{From: *from, To: *to, UserID: *userID, Reanalyze: *reanalyze}
+	if *resume != "" {
+		if options, err = chat_scheduler.ResumeBackfillOptions(db, *resume); err != nil {
+			return err
+		}
+	}
+
+	provider, err := llm.NewProviderFromEnv()
+	if err != nil {
+		return err
+	}
+	metered := llm.NewMeteredProvider(provider, ledger.NewRecorder(db, ledger.PricingFromEnv()))
+	chatScheduler := chat_scheduler.NewChatAnalyzeScheduler(db, metered)
+
+	// Ctrl+C로 중단하면 진행 중인 분석을 취소합니다. 중단된 실행은 -resume 으로 이어서 진행할 수 있습니다
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		if _, ok := <-signals; ok {
+			log.Println("Canceling backfill...")
+			chatScheduler.Stop()
+		}
+	}()
+
+	// 진행 상황은 실행 기록에 저장되므로 다른 터미널에서 GET /admin/job-runs 로 확인할 수 있습니다
+	run, err := chatScheduler.Backfill(*options)
+	if run != nil {
+		if encodeErr := printJSON(run); encodeErr != nil {
+			return encodeErr
+		}
+	}
+	return err
+}
+
+// printJSON은 값을 보기 좋은 JSON으로 출력합니다
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// openCommandDatabase는 관리 명령에서 사용할 데이터베이스 연결만 초기화합니다
+func openCommandDatabase() (*gorm.DB, error) {
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	db, err := database.NewDatabase(database.NewConfig())
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize database connection: %v", err)
+	}
+	return db, nil
+}
@@ -31,14 +31,18 @@ func NewConfig() Config {
 }
 
 func NewDatabase(config Config) (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Seoul",
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=UTC",
 		config.Host,
 		config.User,
 		config.Password,
 		config.DBName,
 		config.Port,
 	)
+	return Open(dsn)
+}
 
+// Open connects to the database at dsn (key=value or postgres:// URL)
+func Open(dsn string) (*gorm.DB, error) {
 	// GORM 로거 설정
 	gormLogger := logger.New(
 		log.New(log.Writer(), "\r\n", log.LstdFlags),
@@ -53,8 +57,9 @@ func NewDatabase(config Config) (*gorm.DB, error) {
 	// 데이터베이스 연결
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: gormLogger,
+		// 모든 시각은 UTC로 저장하고, 날짜 경계는 사용자 시간대 기준으로 계산합니다
 		NowFunc: func() time.Time {
-			return time.Now().In(time.FixedZone("KST", 9*60*60))
+			return time.Now().UTC()
 		},
 	})
 	if err != nil {
@@ -2,15 +2,35 @@ package scheduler
 
 import (
 	"career-log-be/services/note/chat/scheduler"
+	"career-log-be/services/scheduler/core/jobrun"
+	user_scheduler "career-log-be/services/user/scheduler"
+	"log"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 )
 
-// InitSchedulers 모든 스케줄러를 초기화하는 함수
-func InitSchedulers(app *fiber.App, db *gorm.DB) error {
+// InitSchedulers 모든 예약 작업 스케줄러를 초기화하는 함수 (아웃박스 워커는 server.New에서 시작)
+func InitSchedulers(app *fiber.App, db *gorm.DB, chatAnalyzer *scheduler.ChatAnalyzeScheduler) error {
+	// 비정상 종료로 실행 중 상태로 남은 실행 기록 정리
+	if reaped, err := jobrun.ReapOrphaned(db); err != nil {
+		log.Printf("Failed to reap orphaned job runs: %v", err)
+	} else if reaped > 0 {
+		log.Printf("Marked %d orphaned job runs as failed", reaped)
+	}
+
 	// 채팅 분석 스케줄러 초기화
-	if err := scheduler.InitChatAnalyzeScheduler(app, db); err != nil {
+	if err := scheduler.InitChatAnalyzeScheduler(app, chatAnalyzer); err != nil {
+		return err
+	}
+
+	// 탈퇴 계정 영구 삭제 스케줄러 초기화
+	if err := user_scheduler.InitAccountPurgeScheduler(app, db); err != nil {
+		return err
+	}
+
+	// 데이터 내보내기 파일 정리 스케줄러 초기화
+	if err := user_scheduler.InitExportCleanupScheduler(app, db); err != nil {
 		return err
 	}
 
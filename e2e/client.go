@@ -0,0 +1,138 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Client는 API를 호출하는 HTTP 클라이언트입니다. 로그인하면 이후 요청에 액세스 토큰을 붙입니다
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// StatusError는 기대하지 않은 상태 코드의 응답입니다
+type StatusError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Code       string // 에러 응답의 error.code
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s %s: status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// NewClient는 baseURL(예: http://127.0.0.1:3000/api)을 호출하는 Client를 생성합니다
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	return &Client{baseURL: strings.TrimRight(baseURL, "/"), httpClient: httpClient}
+}
+
+// WithToken은 같은 서버를 다른 액세스 토큰으로 호출하는 Client를 반환합니다
+func (c *Client) WithToken(token string) *Client {
+	return &Client{baseURL: c.baseURL, httpClient: c.httpClient, token: token}
+}
+
+// Do는 body를 JSON으로 보내고, 2xx 응답의 data 필드를 out에 디코딩합니다.
+// 2xx가 아닌 응답은 *StatusError로 반환합니다.
+func (c *Client) Do(method, path string, body, out interface{}) (int, error) {
+	resp, err := c.send(method, path, body)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, newStatusError(method, path, resp.StatusCode, raw)
+	}
+
+	if out == nil || len(raw) == 0 {
+		return resp.StatusCode, nil
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return resp.StatusCode, fmt.Errorf("%s %s: invalid response: %v", method, path, err)
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("%s %s: invalid response data: %v", method, path, err)
+	}
+	return resp.StatusCode, nil
+}
+
+// Stream은 서버 전송 이벤트 응답을 읽어 [DONE]까지 받은 조각을 순서대로 반환합니다
+func (c *Client) Stream(path string) ([]string, error) {
+	resp, err := c.send(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(http.MethodGet, path, resp.StatusCode, raw)
+	}
+
+	var chunks []string
+	for _, event := range strings.Split(string(raw), "\n\n") {
+		data, ok := strings.CutPrefix(event, "data: ")
+		if !ok {
+			continue
+		}
+		if data == "[DONE]" {
+			return chunks, nil
+		}
+		chunks = append(chunks, data)
+	}
+	return nil, fmt.Errorf("GET %s: stream ended without [DONE]", path)
+}
+
+func (c *Client) send(method, path string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+func newStatusError(method, path string, statusCode int, raw []byte) *StatusError {
+	var envelope struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	_ = json.Unmarshal(raw, &envelope)
+
+	return &StatusError{Method: method, Path: path, StatusCode: statusCode, Code: envelope.Error.Code, Body: string(raw)}
+}
@@ -0,0 +1,463 @@
+package e2e
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"career-log-be/config/database"
+	"career-log-be/models/note/chat"
+	chatEnums "career-log-be/models/note/chat/enums"
+	"career-log-be/models/scheduler"
+	"career-log-be/models/scheduler/enums"
+	"career-log-be/models/usage"
+	"career-log-be/server"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/mail"
+	"career-log-be/utils/timezone"
+
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// quotaDailyTokens는 테스트 서버의 사용자별 하루 상담 대화 토큰 한도입니다
+const quotaDailyTokens = 100000
+
+// initialScore는 시나리오에서 초기화하는 모든 항목의 만족도와 중요도입니다
+const initialScore = 50.0
+
+// scenarios는 실행할 시나리오 목록입니다
+var scenarios = []struct {
+	name string
+	run  func(h *Harness) error
+}{
+	{"counseling_flow", counselingFlow},
+	{"stream_error", streamError},
+	{"provider_retry", providerRetry},
+	{"usage_quota", usageQuota},
+	{"analysis_repair", analysisRepair},
+	{"malformed_analysis", malformedAnalysis},
+}
+
+// TestScenarios는 가짜 LLM 제공자와 메모리 메일 발송기로 서버를 띄우고 시나리오를 HTTP로 실행합니다.
+// 시나리오가 계정과 데이터를 만들므로 전용 테스트 데이터베이스(E2E_DATABASE_URL)가 있을 때만 실행합니다.
+func TestScenarios(t *testing.T) {
+	dsn := os.Getenv("E2E_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("E2E_DATABASE_URL is not set")
+	}
+	if os.Getenv("JWT_SECRET") == "" && os.Getenv("JWT_KEY_DIR") == "" {
+		t.Setenv("JWT_SECRET", "e2e-secret")
+	}
+	// 한도는 서버를 만들 때 한 번 읽습니다 (usage_quota 시나리오에서 사용)
+	t.Setenv("LLM_DAILY_TOKEN_QUOTA", strconv.Itoa(quotaDailyTokens))
+
+	db, err := database.Open(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+
+	// 예약 작업(cron)은 시작하지 않고, 분석은 시나리오에서 백필로 실행합니다
+	provider := llm.NewFakeProvider()
+	sender := mail.NewMemorySender()
+	app, appCtx, err := server.New(db, server.Dependencies{LLM: llm.NewResilientProvider(provider, llm.ResilienceConfigFromEnv()), Mail: sender})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 서버를 Listen으로 시작하지 않았으므로 에러는 무시하고 워커 종료 훅만 실행합니다
+	t.Cleanup(func() { _ = app.Shutdown() })
+
+	httpServer := httptest.NewServer(adaptor.FiberApp(app))
+	t.Cleanup(httpServer.Close)
+
+	h := NewHarness(NewClient(httpServer.URL+"/api", httpServer.Client()), appCtx.DB, sender, provider)
+	if err := h.setup(); err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			h.LLM.Reset()
+			if err := scenario.run(h); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+type satisfaction struct {
+	Workload          float64 `json:"workload"`
+	Compensation      float64 `json:"compensation"`
+	Growth            float64 `json:"growth"`
+	WorkEnvironment   float64 `json:"workEnvironment"`
+	WorkRelationships float64 `json:"workRelationships"`
+	WorkValues        float64 `json:"workValues"`
+	Score             float64 `json:"score"`
+}
+
+// counselingFlow는 가입 → 프로필 → 만족도 초기화 → 대화 생성 → 스트리밍 → 분석 → 현재 만족도 조회를 검사합니다
+func counselingFlow(h *Harness) error {
+	client, userID, err := h.onboard("flow")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.Script(llm.ChatTask, llm.FakeReply{Chunks: []string{"요즘 ", "업무가 ", "많으셨군요."}, Latency: 50 * time.Millisecond})
+	chunks, err := client.Stream(fmt.Sprintf("/v1/note/chat/%s/stream?message=%s", chatID, url.QueryEscape("야근이 많았어요")))
+	if err != nil {
+		return err
+	}
+	if got := strings.Join(chunks, ""); got != "요즘 업무가 많으셨군요." {
+		return fmt.Errorf("streamed reply = %q", got)
+	}
+
+	var chatSet chat.ChatSet
+	if err := h.DB.Where("id = ?", chatID).First(&chatSet).Error; err != nil {
+		return err
+	}
+	if count := len(chatSet.ChatData.Messages); count != 3 {
+		return fmt.Errorf("chat has %d messages after streaming, want 3", count)
+	}
+
+	h.LLM.Script(llm.AnalysisTask, llm.FakeReply{Content: `{"workload": -10, "compensation": 0, "growth": 5, "workEnvironment": 0, "workRelationships": 0, "workValues": 0}`})
+	run, err := h.backfill(userID)
+	if err != nil {
+		return err
+	}
+	if run.Status != enums.SucceededJobRunStatus || run.Processed != 1 || run.Failed != 0 {
+		return fmt.Errorf("backfill run %s: status=%s processed=%d failed=%d", run.ID, run.Status, run.Processed, run.Failed)
+	}
+
+	// 분석 결과는 아웃박스 워커가 비동기로 반영합니다 (-10 → -1, 5 → 0.125)
+	var current satisfaction
+	err = h.eventually("analysis to be applied", func() (bool, error) {
+		if _, err := client.Do("GET", "/v1/job-satisfaction/current", nil, &current); err != nil {
+			return false, err
+		}
+		return current.Workload != initialScore, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := expectFloat("workload", current.Workload, initialScore-1); err != nil {
+		return err
+	}
+	if err := expectFloat("growth", current.Growth, initialScore+0.125); err != nil {
+		return err
+	}
+	return expectFloat("compensation", current.Compensation, initialScore)
+}
+
+// streamError는 스트리밍 중 제공자가 실패하면 LLM_UNAVAILABLE로 응답하고 대화를 저장하지 않으며, 보낸 만큼의 사용량은 기록하는지 검사합니다
+func streamError(h *Harness) error {
+	client, _, err := h.onboard("stream")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.Script(llm.ChatTask, llm.FakeReply{Chunks: []string{"잠시만"}, Err: errors.New("fake provider failure")})
+	_, err = client.Stream(fmt.Sprintf("/v1/note/chat/%s/stream?message=%s", chatID, url.QueryEscape("안녕하세요")))
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable || statusErr.Code != "LLM_UNAVAILABLE" {
+		return fmt.Errorf("stream error = %v, want status 503 LLM_UNAVAILABLE", err)
+	}
+
+	var chatSet chat.ChatSet
+	if err := h.DB.Where("id = ?", chatID).First(&chatSet).Error; err != nil {
+		return err
+	}
+	if count := len(chatSet.ChatData.Messages); count != 1 {
+		return fmt.Errorf("chat has %d messages after failed stream, want 1", count)
+	}
+
+	// 조각을 보낸 뒤 실패했으므로 어림한 사용량이 기록됩니다
+	var estimated int64
+	if err := h.DB.Model(&usage.LLMUsage{}).Where("source_id = ? AND estimated", chatID).Count(&estimated).Error; err != nil {
+		return err
+	}
+	if estimated != 1 {
+		return fmt.Errorf("recorded %d estimated usage entries for failed stream, want 1", estimated)
+	}
+	return nil
+}
+
+// providerRetry는 제공자가 일시적으로 실패(429, 503)하면 재시도하여 응답을 받는지 검사합니다
+func providerRetry(h *Harness) error {
+	client, _, err := h.onboard("retry")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.Script(llm.ChatTask,
+		llm.FakeReply{Err: &llm.StatusError{Provider: "fake", StatusCode: http.StatusTooManyRequests, RetryAfter: 100 * time.Millisecond, Err: errors.New("rate limited")}},
+		llm.FakeReply{Err: &llm.StatusError{Provider: "fake", StatusCode: http.StatusServiceUnavailable, Err: errors.New("overloaded")}},
+		llm.FakeReply{Content: "다시 연결되었어요."},
+	)
+	chunks, err := client.Stream(fmt.Sprintf("/v1/note/chat/%s/stream?message=%s", chatID, url.QueryEscape("안녕하세요")))
+	if err != nil {
+		return err
+	}
+	if got := strings.Join(chunks, ""); got != "다시 연결되었어요." {
+		return fmt.Errorf("streamed reply = %q", got)
+	}
+	if count := len(h.LLM.Requests()); count != 3 {
+		return fmt.Errorf("provider received %d requests, want 3", count)
+	}
+	return nil
+}
+
+// usageQuota는 상담 응답의 토큰 사용량이 기록되고, 하루 한도를 넘으면 제공자를 호출하지 않고 QUOTA_EXCEEDED로 응답하는지 검사합니다
+func usageQuota(h *Harness) error {
+	client, userID, err := h.onboard("quota")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.Script(llm.ChatTask, llm.FakeReply{Content: "오늘 하루는 어떠셨나요?"})
+	if _, err := client.Stream(fmt.Sprintf("/v1/note/chat/%s/stream?message=%s", chatID, url.QueryEscape("안녕하세요"))); err != nil {
+		return err
+	}
+
+	var summary struct {
+		Total struct {
+			Calls        int64 `json:"calls"`
+			OutputTokens int64 `json:"outputTokens"`
+			TotalTokens  int64 `json:"totalTokens"`
+		} `json:"total"`
+		Quotas []struct {
+			Period   string `json:"period"`
+			Limit    int64  `json:"limit"`
+			Used     int64  `json:"used"`
+			Reserved int64  `json:"reserved"`
+		} `json:"quotas"`
+	}
+	if _, err := client.Do("GET", "/v1/user/usage", nil, &summary); err != nil {
+		return err
+	}
+	if summary.Total.Calls != 1 || summary.Total.OutputTokens != 3 || summary.Total.TotalTokens <= summary.Total.OutputTokens {
+		return fmt.Errorf("usage total = %+v, want 1 call with 3 output tokens", summary.Total)
+	}
+	if len(summary.Quotas) != 1 || summary.Quotas[0].Used != summary.Total.TotalTokens || summary.Quotas[0].Reserved != 0 {
+		return fmt.Errorf("quotas = %+v, want daily quota with used tokens and no reservation", summary.Quotas)
+	}
+
+	// 남은 한도를 모두 사용한 것으로 기록
+	filler := &usage.LLMUsage{UserID: &userID, Task: string(llm.ChatTask), Provider: "fake", Model: "fake-chat", TotalTokens: int(summary.Quotas[0].Limit - summary.Quotas[0].Used)}
+	if err := h.DB.Create(filler).Error; err != nil {
+		return err
+	}
+
+	_, err = client.Stream(fmt.Sprintf("/v1/note/chat/%s/stream?message=%s", chatID, url.QueryEscape("또 왔어요")))
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests || statusErr.Code != "QUOTA_EXCEEDED" {
+		return fmt.Errorf("stream error = %v, want status 429 QUOTA_EXCEEDED", err)
+	}
+	if count := len(h.LLM.Requests()); count != 1 {
+		return fmt.Errorf("provider received %d requests, want 1", count)
+	}
+	return nil
+}
+
+// analysisRepair는 분석 응답이 잘못되면 복구 요청으로 다시 분석하고, 실패한 시도의 원본 응답을 기록하는지 검사합니다
+func analysisRepair(h *Harness) error {
+	client, userID, err := h.onboard("repair")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.Script(llm.AnalysisTask,
+		llm.FakeMalformedJSON,
+		llm.FakeReply{Content: `{"workload": 15, "compensation": 0, "growth": 0, "workEnvironment": 0, "workRelationships": 0, "workValues": 0}`},
+		llm.FakeReply{Content: `{"workload": 10, "compensation": 0, "growth": 0, "workEnvironment": 0, "workRelationships": 0, "workValues": 0}`},
+	)
+	run, err := h.backfill(userID)
+	if err != nil {
+		return err
+	}
+	if run.Processed != 1 || run.Failed != 0 {
+		return fmt.Errorf("backfill run %s: processed=%d failed=%d", run.ID, run.Processed, run.Failed)
+	}
+
+	if err := h.expectFailedAttempts(chatID, 2); err != nil {
+		return err
+	}
+
+	var current satisfaction
+	err = h.eventually("analysis to be applied", func() (bool, error) {
+		if _, err := client.Do("GET", "/v1/job-satisfaction/current", nil, &current); err != nil {
+			return false, err
+		}
+		return current.Workload != initialScore, nil
+	})
+	if err != nil {
+		return err
+	}
+	return expectFloat("workload", current.Workload, initialScore+1)
+}
+
+// malformedAnalysis는 복구 요청에도 응답이 계속 잘못되면 대화를 분석 실패로 남기고 만족도를 바꾸지 않는지 검사합니다
+func malformedAnalysis(h *Harness) error {
+	client, userID, err := h.onboard("malformed")
+	if err != nil {
+		return err
+	}
+
+	chatID, err := h.createChat(client)
+	if err != nil {
+		return err
+	}
+
+	h.LLM.SetDefault(llm.AnalysisTask, llm.FakeMalformedJSON)
+	run, err := h.backfill(userID)
+	if err != nil {
+		return err
+	}
+	if run.Failed != 1 {
+		return fmt.Errorf("backfill run %s: failed=%d, want 1", run.ID, run.Failed)
+	}
+
+	var chatSet chat.ChatSet
+	if err := h.DB.Where("id = ?", chatID).First(&chatSet).Error; err != nil {
+		return err
+	}
+	if chatSet.AnalysisStatus != chatEnums.FailedAnalysisStatus {
+		return fmt.Errorf("chat %s analysis status = %s, want %s", chatID, chatSet.AnalysisStatus, chatEnums.FailedAnalysisStatus)
+	}
+
+	if err := h.expectFailedAttempts(chatID, len(h.LLM.Requests())); err != nil {
+		return err
+	}
+
+	var current satisfaction
+	if _, err := client.Do("GET", "/v1/job-satisfaction/current", nil, &current); err != nil {
+		return err
+	}
+	return expectFloat("workload", current.Workload, initialScore)
+}
+
+// expectFailedAttempts는 대화에 기록된 실패한 분석 시도 수를 검사합니다
+func (h *Harness) expectFailedAttempts(chatID string, want int) error {
+	var count int64
+	if err := h.DB.Model(&chat.ChatAnalysisAttempt{}).Where("chat_set_id = ?", chatID).Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) != want {
+		return fmt.Errorf("chat %s has %d failed analysis attempts, want %d", chatID, count, want)
+	}
+	return nil
+}
+
+// onboard는 새 계정을 만들고 프로필과 직무 만족도를 초기화합니다
+func (h *Harness) onboard(label string) (*Client, string, error) {
+	client, userID, err := h.newAccount(label)
+	if err != nil {
+		return nil, "", err
+	}
+
+	profile := map[string]string{"name": "E2E " + label, "organization": "Career Log", "timezone": timezone.DefaultName}
+	if _, err := client.Do("POST", "/v1/user/profile", profile, nil); err != nil {
+		return nil, "", err
+	}
+
+	scores := map[string]float64{
+		"workload":          initialScore,
+		"compensation":      initialScore,
+		"growth":            initialScore,
+		"workEnvironment":   initialScore,
+		"workRelationships": initialScore,
+		"workValues":        initialScore,
+	}
+	if _, err := client.Do("POST", "/v1/job-satisfaction/importance", scores, nil); err != nil {
+		return nil, "", err
+	}
+
+	var request struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	if _, err := client.Do("POST", "/v1/job-satisfaction/init", scores, &request); err != nil {
+		return nil, "", err
+	}
+
+	err = h.eventually("initialization to be processed", func() (bool, error) {
+		status, err := client.Do("GET", "/v1/job-satisfaction/requests/"+request.ID, nil, &request)
+		if err != nil {
+			return false, err
+		}
+		if request.Status == "DEAD" {
+			return false, fmt.Errorf("initialization request %s failed", request.ID)
+		}
+		return status == http.StatusOK, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return client, userID, nil
+}
+
+// createChat은 사전 대화로 오늘의 대화를 만듭니다
+func (h *Harness) createChat(client *Client) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+	if _, err := client.Do("POST", "/v1/note/chat/create", map[string]string{"pre_chat_id": h.preChatID}, &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// backfill은 사용자의 오늘 대화 분석을 요청하고 실행이 끝날 때까지 기다립니다
+func (h *Harness) backfill(userID string) (*scheduler.ScheduledJobRun, error) {
+	today := time.Now().In(timezone.LoadOrDefault(timezone.DefaultName)).Format("2006-01-02")
+
+	var run scheduler.ScheduledJobRun
+	input := map[string]string{"from": today, "to": today, "userId": userID}
+	if _, err := h.admin.Do("POST", "/v1/admin/chat-analysis/backfill", input, &run); err != nil {
+		return nil, err
+	}
+
+	err := h.eventually("backfill to finish", func() (bool, error) {
+		if err := h.DB.Where("id = ?", run.ID).First(&run).Error; err != nil {
+			return false, err
+		}
+		return run.FinishedAt != nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
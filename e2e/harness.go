@@ -0,0 +1,151 @@
+// Package e2e provides an end-to-end harness that drives the API over HTTP
+// against an in-process server with a fake LLM provider and in-memory mail.
+// The scenarios live in e2e_test.go and run only when E2E_DATABASE_URL is set.
+package e2e
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"time"
+
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/auth/core/rbac"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/mail"
+
+	"gorm.io/gorm"
+)
+
+// accountPassword는 하네스가 만드는 모든 계정의 비밀번호입니다
+const accountPassword = "e2e-password"
+
+var mailTokenPattern = regexp.MustCompile(`token=([^\s&]+)`)
+
+// Harness는 시나리오가 공유하는 서버와 가짜 의존성입니다.
+// 시나리오는 가짜 제공자의 응답 순서에 의존하므로 병렬로 실행하지 않습니다.
+type Harness struct {
+	Client  *Client // 인증하지 않은 클라이언트
+	DB      *gorm.DB
+	Mail    *mail.MemorySender
+	LLM     *llm.FakeProvider
+	Timeout time.Duration // 비동기 처리(아웃박스, 백필)를 기다리는 최대 시간
+
+	admin     *Client
+	preChatID string
+}
+
+// NewHarness는 baseURL의 서버를 호출하는 Harness를 생성합니다
+func NewHarness(client *Client, db *gorm.DB, sender *mail.MemorySender, provider *llm.FakeProvider) *Harness {
+	return &Harness{Client: client, DB: db, Mail: sender, LLM: provider, Timeout: 30 * time.Second}
+}
+
+// setup은 관리자 계정과 시나리오에서 사용할 사전 대화를 만듭니다
+func (h *Harness) setup() error {
+	_, userID, err := h.newAccount("admin")
+	if err != nil {
+		return err
+	}
+
+	var target user.User
+	if err := h.DB.Where("id = ?", userID).First(&target).Error; err != nil {
+		return err
+	}
+	if err := rbac.GrantRole(h.DB, &target, enums.AdminRole); err != nil {
+		return err
+	}
+
+	// 역할은 토큰에 담기므로 다시 로그인합니다
+	if h.admin, err = h.login(target.Email); err != nil {
+		return err
+	}
+
+	var preChat struct {
+		ID string `json:"id"`
+	}
+	if _, err := h.admin.Do("POST", "/v1/note/chat/pre-chats", map[string]string{"content": "오늘 하루는 어떠셨나요?"}, &preChat); err != nil {
+		return err
+	}
+	h.preChatID = preChat.ID
+	return nil
+}
+
+// newAccount는 가입, 이메일 인증, 로그인을 거친 새 계정의 클라이언트와 사용자 ID를 반환합니다
+func (h *Harness) newAccount(label string) (*Client, string, error) {
+	email := fmt.Sprintf("e2e-%s-%d@career-log.local", label, time.Now().UnixNano())
+
+	var registered struct {
+		ID string `json:"id"`
+	}
+	if _, err := h.Client.Do("POST", "/v1/auth/register", map[string]string{"email": email, "password": accountPassword}, &registered); err != nil {
+		return nil, "", err
+	}
+
+	token, err := h.mailToken(email)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := h.Client.Do("POST", "/v1/auth/verify-email", map[string]string{"token": token}, nil); err != nil {
+		return nil, "", err
+	}
+
+	client, err := h.login(email)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, registered.ID, nil
+}
+
+func (h *Harness) login(email string) (*Client, error) {
+	var login struct {
+		Token string `json:"token"`
+	}
+	if _, err := h.Client.Do("POST", "/v1/auth/login", map[string]string{"email": email, "password": accountPassword}, &login); err != nil {
+		return nil, err
+	}
+	return h.Client.WithToken(login.Token), nil
+}
+
+// mailToken은 가장 최근에 email로 보낸 메일의 링크에서 토큰을 꺼냅니다
+func (h *Harness) mailToken(email string) (string, error) {
+	messages := h.Mail.Messages()
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].To != email {
+			continue
+		}
+		match := mailTokenPattern.FindStringSubmatch(messages[i].Body)
+		if match == nil {
+			return "", fmt.Errorf("no token in mail to %s", email)
+		}
+		return url.QueryUnescape(match[1])
+	}
+	return "", fmt.Errorf("no mail sent to %s", email)
+}
+
+// eventually는 check가 true를 반환할 때까지 Timeout 동안 반복합니다
+func (h *Harness) eventually(description string, check func() (bool, error)) error {
+	deadline := time.Now().Add(h.Timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for %s", description)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// expectFloat는 값이 기대값과 같은지 검사합니다
+func expectFloat(name string, got, want float64) error {
+	if math.Abs(got-want) > 1e-9 {
+		return fmt.Errorf("%s = %v, want %v", name, got, want)
+	}
+	return nil
+}
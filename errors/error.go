@@ -54,6 +54,15 @@ func NewAuthorizationError(code ErrorCode, message string) *AppError {
 	}
 }
 
+// NewForbiddenError는 인증은 되었지만 권한이 없는 요청에 대한 에러를 생성합니다
+func NewForbiddenError(code ErrorCode, message string) *AppError {
+	return &AppError{
+		Type:    ErrorTypeForbidden,
+		Code:    code,
+		Message: message,
+	}
+}
+
 // NewNotFoundError는 리소스를 찾을 수 없는 에러를 생성합니다
 func NewNotFoundError(code ErrorCode, message string) *AppError {
 	return &AppError{
@@ -91,3 +100,34 @@ func NewBadRequestError(code ErrorCode, message string) *AppError {
 		Message: message,
 	}
 }
+
+// NewLockedError는 리소스(계정 등)가 잠겨 있는 에러를 생성합니다
+func NewLockedError(code ErrorCode, message string, details any) *AppError {
+	return &AppError{
+		Type:    ErrorTypeLocked,
+		Code:    code,
+		Message: message,
+		Details: details,
+	}
+}
+
+// NewRateLimitedError는 요청 횟수 제한 초과 에러를 생성합니다
+func NewRateLimitedError(code ErrorCode, message string, details any) *AppError {
+	return &AppError{
+		Type:    ErrorTypeRateLimited,
+		Code:    code,
+		Message: message,
+		Details: details,
+	}
+}
+
+// NewUnavailableError는 외부 서비스를 사용할 수 없는 에러를 생성합니다
+func NewUnavailableError(code ErrorCode, message string, err error) *AppError {
+	return &AppError{
+		Type:      ErrorTypeUnavailable,
+		Code:      code,
+		Message:   message,
+		Err:       err,
+		DebugInfo: fmt.Sprintf("%+v", err),
+	}
+}
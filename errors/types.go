@@ -11,10 +11,14 @@ const (
 	// 에러 타입 정의
 	ErrorTypeValidation    ErrorType = "VALIDATION_ERROR"
 	ErrorTypeAuthorization ErrorType = "AUTHORIZATION_ERROR"
+	ErrorTypeForbidden     ErrorType = "FORBIDDEN_ERROR"
 	ErrorTypeNotFound      ErrorType = "NOT_FOUND_ERROR"
 	ErrorTypeInternal      ErrorType = "INTERNAL_ERROR"
 	ErrorTypeConflict      ErrorType = "CONFLICT_ERROR"
 	ErrorTypeBadRequest    ErrorType = "BAD_REQUEST_ERROR"
+	ErrorTypeLocked        ErrorType = "LOCKED_ERROR"
+	ErrorTypeRateLimited   ErrorType = "RATE_LIMITED_ERROR"
+	ErrorTypeUnavailable   ErrorType = "UNAVAILABLE_ERROR"
 )
 
 // ErrorCode는 구체적인 에러 코드를 나타냅니다
@@ -22,10 +26,20 @@ type ErrorCode string
 
 const (
 	// 인증 관련 에러
-	ErrorCodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
-	ErrorCodeTokenRequired      ErrorCode = "TOKEN_REQUIRED"
-	ErrorCodeInvalidToken       ErrorCode = "INVALID_TOKEN"
-	ErrorCodeTokenExpired       ErrorCode = "TOKEN_EXPIRED"
+	ErrorCodeInvalidCredentials     ErrorCode = "INVALID_CREDENTIALS"
+	ErrorCodeTokenRequired          ErrorCode = "TOKEN_REQUIRED"
+	ErrorCodeInvalidToken           ErrorCode = "INVALID_TOKEN"
+	ErrorCodeTokenExpired           ErrorCode = "TOKEN_EXPIRED"
+	ErrorCodeTokenRevoked           ErrorCode = "TOKEN_REVOKED"
+	ErrorCodeEmailNotVerified       ErrorCode = "EMAIL_NOT_VERIFIED"
+	ErrorCodeAccountLocked          ErrorCode = "ACCOUNT_LOCKED"
+	ErrorCodeInvalidMFACode         ErrorCode = "INVALID_MFA_CODE"
+	ErrorCodeMFARequired            ErrorCode = "MFA_REQUIRED"
+	ErrorCodeExternalAuthFailed     ErrorCode = "EXTERNAL_AUTH_FAILED"
+	ErrorCodeInsufficientScope      ErrorCode = "INSUFFICIENT_SCOPE"
+	ErrorCodeForbidden              ErrorCode = "FORBIDDEN"
+	ErrorCodeReauthRequired         ErrorCode = "REAUTHENTICATION_REQUIRED"
+	ErrorCodeAccountPendingDeletion ErrorCode = "ACCOUNT_PENDING_DELETION"
 
 	// 유효성 검사 관련 에러
 	ErrorCodeInvalidInput  ErrorCode = "INVALID_INPUT"
@@ -40,14 +54,22 @@ const (
 	// 서버 관련 에러
 	ErrorCodeDatabaseError ErrorCode = "DATABASE_ERROR"
 	ErrorCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+
+	// 외부 서비스 관련 에러
+	ErrorCodeLLMUnavailable ErrorCode = "LLM_UNAVAILABLE"
+	ErrorCodeQuotaExceeded  ErrorCode = "QUOTA_EXCEEDED"
 )
 
 // 에러 타입에 따른 HTTP 상태 코드 매핑
 var errorTypeToStatusCode = map[ErrorType]int{
 	ErrorTypeValidation:    http.StatusBadRequest,
 	ErrorTypeAuthorization: http.StatusUnauthorized,
+	ErrorTypeForbidden:     http.StatusForbidden,
 	ErrorTypeNotFound:      http.StatusNotFound,
 	ErrorTypeInternal:      http.StatusInternalServerError,
 	ErrorTypeConflict:      http.StatusConflict,
 	ErrorTypeBadRequest:    http.StatusBadRequest,
+	ErrorTypeLocked:        http.StatusLocked,
+	ErrorTypeRateLimited:   http.StatusTooManyRequests,
+	ErrorTypeUnavailable:   http.StatusServiceUnavailable,
 }
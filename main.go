@@ -3,13 +3,10 @@ package main
 import (
 	"career-log-be/config/database"
 	"career-log-be/config/scheduler"
-	"career-log-be/middleware"
-	job_satisfaction "career-log-be/models/job_satisfaction"
-	"career-log-be/models/note/chat"
-	user "career-log-be/models/user"
-	"career-log-be/routes"
-	"career-log-be/utils/chatgpt"
-	"career-log-be/utils/jwt"
+	"career-log-be/server"
+	"career-log-be/services/auth/core/rbac"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/mail"
 	"fmt"
 	"log"
 	"os"
@@ -18,27 +15,24 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/joho/godotenv"
-	"gorm.io/gorm"
 )
 
-type AppContext struct {
-	DB  *gorm.DB
-	JWT *jwt.JWTUtils
-}
-
-func initialize() (*fiber.App, *AppContext, error) {
+func initialize() (*fiber.App, *server.Context, error) {
 	// .env 파일 로드
 	if err := godotenv.Load(); err != nil {
 		log.Println("Warning: .env file not found")
 	}
 
-	// JWT 유틸리티 초기화
-	jwtUtils := jwt.NewJWTUtils()
+	// LLM 제공자 초기화
+	llmProvider, err := llm.NewProviderFromEnv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not initialize LLM provider: %v", err)
+	}
 
-	// ChatGPT 서비스 초기화
-	chatGPTService, err := chatgpt.NewChatGPTBuilder().Build()
+	// 메일 발송기 초기화
+	mailSender, err := mail.NewSenderFromEnv()
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not initialize ChatGPT service: %v", err)
+		return nil, nil, fmt.Errorf("could not initialize mail sender: %v", err)
 	}
 
 	// 데이터베이스 설정 및 연결
@@ -48,40 +42,22 @@ func initialize() (*fiber.App, *AppContext, error) {
 		return nil, nil, fmt.Errorf("could not initialize database connection: %v", err)
 	}
 
-	// Auto Migrate
-	if err := db.AutoMigrate(
-		&user.User{},
-		&user.UserProfile{},
-		&job_satisfaction.UserJobSatisfactionImportance{},
-		&job_satisfaction.UserJobSatisfaction{},
-		&job_satisfaction.JobSatisfactionUpdateEvent{},
-		&chat.ChatSet{},
-		&chat.PreChat{},
-	); err != nil {
-		return nil, nil, fmt.Errorf("could not migrate database: %v", err)
+	if err := server.Migrate(db); err != nil {
+		return nil, nil, err
 	}
 
-	// Fiber 앱 생성 (에러 핸들러 등록)
-	app := fiber.New(fiber.Config{
-		ErrorHandler: middleware.ErrorHandler(),
-	})
-
-	// 컨텍스트 생성
-	appCtx := &AppContext{
-		DB:  db,
-		JWT: jwtUtils,
+	// 관리자 계정 지정
+	if err := rbac.BootstrapAdminsFromEnv(db); err != nil {
+		return nil, nil, fmt.Errorf("could not bootstrap admin users: %v", err)
 	}
 
-	// 미들웨어 설정
-	app.Use(middleware.DatabaseMiddleware(db))
-	app.Use(middleware.JWTMiddleware(jwtUtils))
-	app.Use(middleware.ChatGPTMiddleware(chatGPTService))
-
-	// 라우터 설정
-	routes.SetupRoutes(app)
+	app, appCtx, err := server.New(db, server.Dependencies{LLM: llmProvider, Mail: mailSender})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// 스케줄러 초기화
-	if err := scheduler.InitSchedulers(app, db); err != nil {
+	if err := scheduler.InitSchedulers(app, db, appCtx.ChatAnalyzer); err != nil {
 		return nil, nil, fmt.Errorf("could not initialize schedulers: %v", err)
 	}
 
@@ -89,6 +65,14 @@ func initialize() (*fiber.App, *AppContext, error) {
 }
 
 func main() {
+	// 관리 명령 실행 (예: go run . replay -dry-run)
+	if handled, err := runCommand(os.Args[1:]); handled {
+		if err != nil {
+			log.Fatalf("Command failed: %v", err)
+		}
+		return
+	}
+
 	app, appCtx, err := initialize()
 	if err != nil {
 		log.Fatalf("Failed to initialize application: %v", err)
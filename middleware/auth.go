@@ -2,18 +2,23 @@ package middleware
 
 import (
 	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/pat"
 	"career-log-be/utils/jwt"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
 )
 
-// AuthMiddleware checks for valid JWT token and sets user info in context
+// 인증 방식 (c.Locals("authType"))
+const (
+	AuthTypeJWT                 = "jwt"
+	AuthTypePersonalAccessToken = "pat"
+)
+
+// AuthMiddleware checks for a valid JWT or personal access token and sets user info in context
 func AuthMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Get JWT utils from context
-		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
-
 		// Get token from Authorization header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
@@ -34,6 +39,28 @@ func AuthMiddleware() fiber.Handler {
 
 		tokenString := parts[1]
 
+		// Personal access token
+		if pat.IsPersonalAccessToken(tokenString) {
+			db := c.Locals("db").(*gorm.DB)
+
+			accessToken, owner, err := pat.Authenticate(db, tokenString)
+			if err != nil {
+				return err
+			}
+
+			c.Locals("userID", owner.ID)
+			c.Locals("userEmail", owner.Email)
+			c.Locals("authType", AuthTypePersonalAccessToken)
+			c.Locals("scopes", accessToken.ScopeList())
+			// 개인 액세스 토큰에는 역할을 부여하지 않음 (관리자 소유 토큰도 관리 API 호출 불가)
+			c.Locals("roles", []string{})
+
+			return c.Next()
+		}
+
+		// Get JWT utils from context
+		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
+
 		// Validate token and get claims
 		claims, err := jwtUtils.ValidateToken(tokenString)
 		if err != nil {
@@ -46,6 +73,10 @@ func AuthMiddleware() fiber.Handler {
 		// Set user information in context
 		c.Locals("userID", claims.ID)
 		c.Locals("userEmail", claims.Email)
+		c.Locals("sessionID", claims.SessionID)
+		c.Locals("amr", claims.AMR)
+		c.Locals("roles", claims.Roles)
+		c.Locals("authType", AuthTypeJWT)
 
 		return c.Next()
 	}
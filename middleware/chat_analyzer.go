@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"career-log-be/services/note/chat/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ChatAnalyzerMiddleware(chatAnalyzer *scheduler.ChatAnalyzeScheduler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("chatAnalyzer", chatAnalyzer)
+		return c.Next()
+	}
+}
@@ -2,8 +2,11 @@ package middleware
 
 import (
 	appErrors "career-log-be/errors"
+	"career-log-be/utils/llm"
 	"errors"
 	"log"
+	"math"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -17,6 +20,15 @@ func ErrorHandler() fiber.ErrorHandler {
 		errorCode := appErrors.ErrorCodeInternalError
 		var details any
 
+		// LLM 제공자 장애는 LLM_UNAVAILABLE로 응답하고, 다시 시도할 시점을 알면 Retry-After로 알려줍니다
+		var unavailable *llm.UnavailableError
+		if errors.As(err, &unavailable) {
+			if unavailable.RetryAfter > 0 {
+				c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(unavailable.RetryAfter.Seconds()))))
+			}
+			err = appErrors.NewUnavailableError(appErrors.ErrorCodeLLMUnavailable, "LLM provider is unavailable", err)
+		}
+
 		// AppError 타입인지 확인
 		var appError *appErrors.AppError
 		if errors.As(err, &appError) {
@@ -25,8 +37,8 @@ func ErrorHandler() fiber.ErrorHandler {
 			errorCode = appError.Code
 			details = appError.Details
 
-			// 내부 에러는 로깅
-			if appError.Type == appErrors.ErrorTypeInternal {
+			// 내부 에러와 외부 서비스 장애는 로깅
+			if appError.Type == appErrors.ErrorTypeInternal || appError.Type == appErrors.ErrorTypeUnavailable {
 				log.Printf("Internal error: %s\nDebug info: %s", appError.Error(), appError.DebugInfo)
 			}
 		} else {
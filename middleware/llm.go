@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"career-log-be/utils/llm"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func LLMMiddleware(provider llm.LLMProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("llm", provider)
+		return c.Next()
+	}
+}
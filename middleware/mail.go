@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"career-log-be/utils/mail"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func MailMiddleware(sender mail.Sender) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("mail", sender)
+		return c.Next()
+	}
+}
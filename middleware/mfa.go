@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	appErrors "career-log-be/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireMFA는 2단계 인증을 거쳐 발급된 토큰만 통과시킵니다. AuthMiddleware 뒤에 사용해야 합니다
+func RequireMFA() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		amr, _ := c.Locals("amr").([]string)
+		for _, method := range amr {
+			if method == "mfa" {
+				return c.Next()
+			}
+		}
+
+		return appErrors.NewForbiddenError(
+			appErrors.ErrorCodeMFARequired,
+			"Two-factor authentication is required for this action",
+		)
+	}
+}
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"career-log-be/services/auth/core/oauth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func OAuthMiddleware(registry *oauth.Registry) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("oauth", registry)
+		return c.Next()
+	}
+}
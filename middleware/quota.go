@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"career-log-be/services/usage/core/ledger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func QuotaMiddleware(limiter *ledger.QuotaLimiter) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("quota", limiter)
+		return c.Next()
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user/enums"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireRole은 주어진 역할 중 하나라도 가진 사용자만 통과시킵니다. AuthMiddleware 뒤에 사용해야 합니다
+func RequireRole(roles ...enums.Role) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		granted, _ := c.Locals("roles").([]string)
+		for _, role := range granted {
+			for _, required := range roles {
+				if role == required.String() {
+					return c.Next()
+				}
+			}
+		}
+
+		return appErrors.NewForbiddenError(
+			appErrors.ErrorCodeForbidden,
+			"You do not have the required role for this action",
+		)
+	}
+}
+
+// RequirePermission은 역할을 통해 주어진 권한을 가진 사용자만 통과시킵니다. AuthMiddleware 뒤에 사용해야 합니다
+func RequirePermission(permission enums.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		granted, _ := c.Locals("roles").([]string)
+		for _, role := range granted {
+			if enums.Role(role).HasPermission(permission) {
+				return c.Next()
+			}
+		}
+
+		return appErrors.NewForbiddenError(
+			appErrors.ErrorCodeForbidden,
+			"You do not have permission for this action: "+permission.String(),
+		)
+	}
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	appErrors "career-log-be/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireScope는 개인 액세스 토큰 요청에 리소스 스코프가 있는지 확인합니다.
+// GET/HEAD 요청은 "<resource>:read", 그 외에는 "<resource>:write" 스코프가 필요합니다.
+// 로그인 세션(JWT) 요청은 모든 스코프를 가진 것으로 간주합니다. AuthMiddleware 뒤에 사용해야 합니다.
+func RequireScope(resource string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals("authType") != AuthTypePersonalAccessToken {
+			return c.Next()
+		}
+
+		required := resource + ":write"
+		if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+			required = resource + ":read"
+		}
+
+		scopes, _ := c.Locals("scopes").([]string)
+		for _, scope := range scopes {
+			if scope == required {
+				return c.Next()
+			}
+		}
+
+		return appErrors.NewForbiddenError(
+			appErrors.ErrorCodeInsufficientScope,
+			"Token does not have the required scope: "+required,
+		)
+	}
+}
+
+// RequireInteractiveSession은 로그인 세션(JWT)으로만 접근할 수 있도록 제한합니다.
+// 토큰 발급, 2단계 인증 설정처럼 개인 액세스 토큰으로 허용하면 안 되는 API에 사용합니다.
+func RequireInteractiveSession() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals("authType") != AuthTypeJWT {
+			return appErrors.NewForbiddenError(
+				appErrors.ErrorCodeInsufficientScope,
+				"This action requires a signed-in session",
+			)
+		}
+		return c.Next()
+	}
+}
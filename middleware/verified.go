@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// RequireVerifiedEmail은 이메일 인증을 완료한 사용자만 통과시킵니다. AuthMiddleware 뒤에 사용해야 합니다
+func RequireVerifiedEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		var target user.User
+		if err := db.Select("id", "email_verified_at").Where("id = ?", userID).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewAuthorizationError(
+					appErrors.ErrorCodeInvalidToken,
+					"User not found",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		if !target.IsEmailVerified() {
+			return appErrors.NewForbiddenError(
+				appErrors.ErrorCodeEmailNotVerified,
+				"Email verification is required",
+			)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,38 @@
+package enums
+
+import "database/sql/driver"
+
+// OutboxStatus는 아웃박스에 쌓인 만족도 변경 요청의 처리 상태입니다
+type OutboxStatus string
+
+const (
+	PendingOutboxStatus    OutboxStatus = "PENDING"    // 처리 대기 (재시도 대기 포함)
+	ProcessingOutboxStatus OutboxStatus = "PROCESSING" // 워커가 처리 중
+	DoneOutboxStatus       OutboxStatus = "DONE"       // 처리 완료
+	DeadOutboxStatus       OutboxStatus = "DEAD"       // 재시도 횟수 초과로 처리 중단
+)
+
+// Value - SQL을 위한 직렬화
+func (s OutboxStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (s *OutboxStatus) Scan(value interface{}) error {
+	*s = OutboxStatus(value.(string))
+	return nil
+}
+
+// IsValid - 상태 유효성 검사
+func (s OutboxStatus) IsValid() bool {
+	switch s {
+	case PendingOutboxStatus, ProcessingOutboxStatus, DoneOutboxStatus, DeadOutboxStatus:
+		return true
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (s OutboxStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,37 @@
+package job_satisfaction
+
+import (
+	"career-log-be/models/job_satisfaction/enums"
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobSatisfactionOutboxPrefix = "JOB_SAT_OUTBOX"
+)
+
+// JobSatisfactionOutbox는 아직 반영되지 않은 만족도 변경 요청입니다.
+// 요청과 같은 트랜잭션에서 저장되고, 아웃박스 워커가 사용자별 순서대로 처리합니다.
+type JobSatisfactionOutbox struct {
+	ID            string                               `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID        string                               `json:"userId" gorm:"type:varchar(100);index;not null"`
+	EventType     enums.JobSatisfactionUpdateEventType `json:"eventType" gorm:"type:varchar(20);not null"`
+	Payload       string                               `json:"-" gorm:"type:jsonb;not null"`          // 반영할 JobSatisfactionUpdateEvent (JSON)
+	Replace       bool                                 `json:"replace" gorm:"not null;default:false"` // 같은 출처의 이전 변화량을 더하지 않고 대체할지 여부
+	Status        enums.OutboxStatus                   `json:"status" gorm:"type:varchar(20);index;not null"`
+	Attempts      int                                  `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt time.Time                            `json:"nextAttemptAt" gorm:"index;not null"`
+	LockedUntil   *time.Time                           `json:"-"`
+	LastError     string                               `json:"lastError,omitempty"`
+	EventID       *string                              `json:"eventId"` // 처리 결과로 생성된 JobSatisfactionUpdateEvent ID
+	ProcessedAt   *time.Time                           `json:"processedAt"`
+	CreatedAt     time.Time                            `json:"createdAt"`
+	UpdatedAt     time.Time                            `json:"updatedAt"`
+}
+
+func (o *JobSatisfactionOutbox) BeforeCreate(tx *gorm.DB) error {
+	o.ID = utils.GenerateID(JobSatisfactionOutboxPrefix)
+	return nil
+}
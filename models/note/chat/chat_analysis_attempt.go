@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ChatAnalysisAttemptPrefix = "CH_ANALYSIS_ATTEMPT"
+)
+
+// ChatAnalysisAttempt는 대화 분석 중 올바르지 않은 응답을 받은 시도의 원본 응답과 실패 사유입니다
+type ChatAnalysisAttempt struct {
+	ID            string    `gorm:"primaryKey;type:varchar(100)" json:"id"`
+	ChatSetID     string    `gorm:"type:varchar(100);index;not null" json:"chat_set_id"`
+	UserID        string    `gorm:"type:varchar(100);index;not null" json:"user_id"`
+	Attempt       int       `gorm:"not null" json:"attempt"` // 분석 한 번 안에서의 시도 순서 (1부터)
+	Model         string    `gorm:"type:varchar(100);not null" json:"model"`
+	PromptVersion string    `gorm:"type:varchar(20);not null" json:"prompt_version"`
+	RawResponse   string    `gorm:"type:text;not null" json:"raw_response"`
+	Error         string    `gorm:"type:text;not null" json:"error"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (attempt *ChatAnalysisAttempt) BeforeCreate(tx *gorm.DB) error {
+	attempt.ID = utils.GenerateID(ChatAnalysisAttemptPrefix)
+	return nil
+}
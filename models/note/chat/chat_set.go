@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"career-log-be/models/note/chat/enums"
 	"career-log-be/utils"
 	"time"
 
@@ -19,6 +20,12 @@ type ChatSet struct {
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 직무 만족도 분석 상태
+	AnalysisStatus        enums.AnalysisStatus `gorm:"type:varchar(20);not null;default:'PENDING';index" json:"analysis_status"`
+	AnalyzedAt            *time.Time           `json:"analyzed_at"`
+	AnalysisModel         string               `gorm:"type:varchar(100);not null;default:''" json:"analysis_model"`
+	AnalysisPromptVersion string               `gorm:"type:varchar(20);not null;default:''" json:"analysis_prompt_version"`
 }
 
 func (chat *ChatSet) BeforeCreate(tx *gorm.DB) error {
@@ -0,0 +1,40 @@
+package enums
+
+import "database/sql/driver"
+
+// AnalysisStatus는 대화의 직무 만족도 분석 상태를 나타내는 타입입니다
+type AnalysisStatus string
+
+const (
+	// PendingAnalysisStatus는 아직 분석하지 않은 대화를 나타냅니다
+	PendingAnalysisStatus AnalysisStatus = "PENDING"
+	// AnalyzedAnalysisStatus는 분석 결과가 반영 대기열에 저장된 대화를 나타냅니다
+	AnalyzedAnalysisStatus AnalysisStatus = "ANALYZED"
+	// FailedAnalysisStatus는 분석에 실패한 대화를 나타냅니다 (수동 분석이나 백필 때 다시 시도합니다)
+	FailedAnalysisStatus AnalysisStatus = "FAILED"
+)
+
+// Value - SQL을 위한 직렬화
+func (s AnalysisStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (s *AnalysisStatus) Scan(value interface{}) error {
+	*s = AnalysisStatus(value.(string))
+	return nil
+}
+
+// String은 AnalysisStatus를 문자열로 변환합니다
+func (s AnalysisStatus) String() string {
+	return string(s)
+}
+
+// IsValid는 AnalysisStatus가 유효한 값인지 검사합니다
+func (s AnalysisStatus) IsValid() bool {
+	switch s {
+	case PendingAnalysisStatus, AnalyzedAnalysisStatus, FailedAnalysisStatus:
+		return true
+	}
+	return false
+}
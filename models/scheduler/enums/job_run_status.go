@@ -0,0 +1,37 @@
+package enums
+
+import "database/sql/driver"
+
+// JobRunStatus는 예약 작업 실행의 상태입니다
+type JobRunStatus string
+
+const (
+	RunningJobRunStatus   JobRunStatus = "RUNNING"
+	SucceededJobRunStatus JobRunStatus = "SUCCEEDED"
+	FailedJobRunStatus    JobRunStatus = "FAILED"
+)
+
+// Value - SQL을 위한 직렬화
+func (s JobRunStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (s *JobRunStatus) Scan(value interface{}) error {
+	*s = JobRunStatus(value.(string))
+	return nil
+}
+
+// IsValid - 상태 유효성 검사
+func (s JobRunStatus) IsValid() bool {
+	switch s {
+	case RunningJobRunStatus, SucceededJobRunStatus, FailedJobRunStatus:
+		return true
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (s JobRunStatus) String() string {
+	return string(s)
+}
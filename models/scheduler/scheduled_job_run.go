@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"career-log-be/models/scheduler/enums"
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ScheduledJobRunPrefix = "JOB_RUN"
+)
+
+// ScheduledJobRun은 예약 작업 한 번의 실행 기록입니다. 작업 잠금을 얻은 인스턴스에서 실행된 경우만 기록됩니다
+type ScheduledJobRun struct {
+	ID         string             `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	JobName    string             `json:"jobName" gorm:"type:varchar(100);index;not null"`
+	LockName   string             `json:"lockName" gorm:"type:varchar(100);not null;default:''"` // 작업 잠금 이름 (비어 있으면 작업 이름)
+	Instance   string             `json:"instance" gorm:"type:varchar(255);not null"`            // 실행한 서버 (호스트 이름:PID)
+	Status     enums.JobRunStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Params     *string            `json:"params,omitempty" gorm:"type:jsonb"` // 실행 옵션 (JSON, 옵션이 있는 작업만)
+	Total      int                `json:"total" gorm:"not null;default:0"`    // 처리 대상 건수 (알 수 있는 작업만)
+	Processed  int                `json:"processed" gorm:"not null;default:0"`
+	Failed     int                `json:"failed" gorm:"not null;default:0"`
+	Skipped    int                `json:"skipped" gorm:"not null;default:0"` // 외부 장애 등으로 처리하지 못해 다음 실행으로 미룬 건수
+	Error      string             `json:"error,omitempty"`
+	StartedAt  time.Time          `json:"startedAt" gorm:"index;not null"`
+	FinishedAt *time.Time         `json:"finishedAt"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+func (r *ScheduledJobRun) BeforeCreate(tx *gorm.DB) error {
+	r.ID = utils.GenerateID(ScheduledJobRunPrefix)
+	return nil
+}
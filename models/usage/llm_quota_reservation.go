@@ -0,0 +1,27 @@
+package usage
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	LLMQuotaReservationPrefix = "LLM_RSV"
+)
+
+// LLMQuotaReservation은 진행 중인 상담 응답이 사용할 것으로 예상해 미리 잡아둔 토큰입니다.
+// 응답이 끝나면 삭제되며, 서버가 중단되어 남은 예약은 ExpiresAt 이후 한도 계산에서 제외됩니다.
+type LLMQuotaReservation struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID    string    `json:"userId" gorm:"type:varchar(100);index;not null"`
+	Tokens    int64     `json:"tokens" gorm:"not null"`
+	ExpiresAt time.Time `json:"expiresAt" gorm:"not null"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (r *LLMQuotaReservation) BeforeCreate(tx *gorm.DB) error {
+	r.ID = utils.GenerateID(LLMQuotaReservationPrefix)
+	return nil
+}
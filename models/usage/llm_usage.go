@@ -0,0 +1,33 @@
+package usage
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	LLMUsagePrefix = "LLM_USAGE"
+)
+
+// LLMUsage는 LLM 호출 한 번의 토큰 사용량과 예상 비용입니다 (사용량 원장)
+type LLMUsage struct {
+	ID           string    `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID       *string   `json:"userId" gorm:"type:varchar(100);index:idx_llm_usage_user_created"` // 사용자와 무관한 호출이면 nil
+	Task         string    `json:"task" gorm:"type:varchar(20);not null"`                            // chat, analysis
+	SourceID     *string   `json:"sourceId" gorm:"type:varchar(100)"`                                // 호출한 대화(ChatSet) ID
+	Provider     string    `json:"provider" gorm:"type:varchar(50);not null"`
+	Model        string    `json:"model" gorm:"type:varchar(100);not null"`
+	InputTokens  int       `json:"inputTokens" gorm:"not null;default:0"`
+	OutputTokens int       `json:"outputTokens" gorm:"not null;default:0"`
+	TotalTokens  int       `json:"totalTokens" gorm:"not null;default:0"`
+	CostUSD      float64   `json:"costUsd" gorm:"not null;default:0"`       // 모델 가격표로 계산한 예상 비용 (USD)
+	Estimated    bool      `json:"estimated" gorm:"not null;default:false"` // 중간에 실패한 스트리밍처럼 토큰 수를 어림한 경우
+	CreatedAt    time.Time `json:"createdAt" gorm:"index:idx_llm_usage_user_created;index"`
+}
+
+func (u *LLMUsage) BeforeCreate(tx *gorm.DB) error {
+	u.ID = utils.GenerateID(LLMUsagePrefix)
+	return nil
+}
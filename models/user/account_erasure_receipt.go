@@ -0,0 +1,28 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	AccountErasureReceiptPrefix = "USR_ERA"
+)
+
+// AccountErasureReceipt는 탈퇴 계정의 데이터를 영구 삭제한 기록입니다.
+// 개인정보는 남기지 않고, 사용자 ID와 테이블별 삭제 건수만 저장합니다.
+type AccountErasureReceipt struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID      string    `json:"userId" gorm:"type:varchar(100);index;not null"`
+	RequestedAt time.Time `json:"requestedAt" gorm:"not null"`
+	PurgedAt    time.Time `json:"purgedAt" gorm:"not null"`
+	DeletedRows string    `json:"deletedRows" gorm:"type:jsonb;not null"` // 테이블별 삭제 건수 (JSON)
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func (r *AccountErasureReceipt) BeforeCreate(tx *gorm.DB) error {
+	r.ID = utils.GenerateID(AccountErasureReceiptPrefix)
+	return nil
+}
@@ -0,0 +1,39 @@
+package enums
+
+import "database/sql/driver"
+
+// ExportStatus는 개인 데이터 내보내기 작업의 상태입니다
+type ExportStatus string
+
+const (
+	PendingExportStatus    ExportStatus = "PENDING"
+	ProcessingExportStatus ExportStatus = "PROCESSING"
+	ReadyExportStatus      ExportStatus = "READY"
+	FailedExportStatus     ExportStatus = "FAILED"
+	ExpiredExportStatus    ExportStatus = "EXPIRED"
+)
+
+// Value - SQL을 위한 직렬화
+func (s ExportStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (s *ExportStatus) Scan(value interface{}) error {
+	*s = ExportStatus(value.(string))
+	return nil
+}
+
+// IsValid - 상태 유효성 검사
+func (s ExportStatus) IsValid() bool {
+	switch s {
+	case PendingExportStatus, ProcessingExportStatus, ReadyExportStatus, FailedExportStatus, ExpiredExportStatus:
+		return true
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (s ExportStatus) String() string {
+	return string(s)
+}
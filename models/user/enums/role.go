@@ -0,0 +1,76 @@
+package enums
+
+import "database/sql/driver"
+
+// Role은 사용자 역할을 나타냅니다
+type Role string
+
+const (
+	UserRole  Role = "user"
+	AdminRole Role = "admin"
+)
+
+// Permission은 역할에 부여되는 관리 권한을 나타냅니다
+type Permission string
+
+const (
+	// ManagePreChatsPermission - 전체 사용자에게 노출되는 사전 대화(PreChat) 생성
+	ManagePreChatsPermission Permission = "pre_chat:manage"
+	// RunAnalysisPermission - 전체 사용자 대상 일일 분석 수동 실행
+	RunAnalysisPermission Permission = "analysis:run"
+	// ReplayProjectionsPermission - 이벤트 로그로 직무 만족도 재계산
+	ReplayProjectionsPermission Permission = "projection:replay"
+	// ViewJobRunsPermission - 예약 작업 실행 기록 조회
+	ViewJobRunsPermission Permission = "job_run:read"
+	// ViewUsagePermission - 전체 사용자의 LLM 사용량/비용 보고서 조회
+	ViewUsagePermission Permission = "usage:read"
+)
+
+// rolePermissions는 역할별로 부여되는 권한 목록입니다
+var rolePermissions = map[Role][]Permission{
+	UserRole: {},
+	AdminRole: {
+		ManagePreChatsPermission,
+		RunAnalysisPermission,
+		ReplayProjectionsPermission,
+		ViewJobRunsPermission,
+		ViewUsagePermission,
+	},
+}
+
+// Value - SQL을 위한 직렬화
+func (r Role) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (r *Role) Scan(value interface{}) error {
+	*r = Role(value.(string))
+	return nil
+}
+
+// IsValid - 역할 유효성 검사
+func (r Role) IsValid() bool {
+	_, exists := rolePermissions[r]
+	return exists
+}
+
+// HasPermission - 역할에 권한이 부여되어 있는지 확인
+func (r Role) HasPermission(permission Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (r Role) String() string {
+	return string(r)
+}
+
+// String - 문자열 변환
+func (p Permission) String() string {
+	return string(p)
+}
@@ -0,0 +1,63 @@
+package enums
+
+import "database/sql/driver"
+
+// Seniority는 사용자의 직급(경력 단계)을 나타냅니다
+type Seniority string
+
+const (
+	InternSeniority    Seniority = "INTERN"
+	JuniorSeniority    Seniority = "JUNIOR"
+	MidSeniority       Seniority = "MID"
+	SeniorSeniority    Seniority = "SENIOR"
+	LeadSeniority      Seniority = "LEAD"
+	ManagerSeniority   Seniority = "MANAGER"
+	ExecutiveSeniority Seniority = "EXECUTIVE"
+)
+
+// Value - SQL을 위한 직렬화
+func (s Seniority) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (s *Seniority) Scan(value interface{}) error {
+	*s = Seniority(value.(string))
+	return nil
+}
+
+// IsValid - 직급 유효성 검사
+func (s Seniority) IsValid() bool {
+	switch s {
+	case InternSeniority, JuniorSeniority, MidSeniority, SeniorSeniority,
+		LeadSeniority, ManagerSeniority, ExecutiveSeniority:
+		return true
+	}
+	return false
+}
+
+// Label - 프롬프트 등에 사용할 한글 표기
+func (s Seniority) Label() string {
+	switch s {
+	case InternSeniority:
+		return "인턴"
+	case JuniorSeniority:
+		return "주니어"
+	case MidSeniority:
+		return "미들"
+	case SeniorSeniority:
+		return "시니어"
+	case LeadSeniority:
+		return "리드"
+	case ManagerSeniority:
+		return "매니저"
+	case ExecutiveSeniority:
+		return "임원"
+	}
+	return string(s)
+}
+
+// String - 문자열 변환
+func (s Seniority) String() string {
+	return string(s)
+}
@@ -0,0 +1,37 @@
+package enums
+
+import "database/sql/driver"
+
+// TokenPurpose는 일회용 사용자 토큰의 용도를 나타냅니다
+type TokenPurpose string
+
+const (
+	PasswordResetPurpose     TokenPurpose = "PASSWORD_RESET"
+	EmailVerificationPurpose TokenPurpose = "EMAIL_VERIFICATION"
+	AccountRestorePurpose    TokenPurpose = "ACCOUNT_RESTORE"
+)
+
+// Value - SQL을 위한 직렬화
+func (p TokenPurpose) Value() (driver.Value, error) {
+	return string(p), nil
+}
+
+// Scan - SQL에서 역직렬화
+func (p *TokenPurpose) Scan(value interface{}) error {
+	*p = TokenPurpose(value.(string))
+	return nil
+}
+
+// IsValid - 토큰 용도 유효성 검사
+func (p TokenPurpose) IsValid() bool {
+	switch p {
+	case PasswordResetPurpose, EmailVerificationPurpose, AccountRestorePurpose:
+		return true
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (p TokenPurpose) String() string {
+	return string(p)
+}
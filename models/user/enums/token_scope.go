@@ -0,0 +1,29 @@
+package enums
+
+// TokenScope는 개인 액세스 토큰이 접근할 수 있는 API 범위입니다. "<리소스>:<read|write>" 형식입니다
+type TokenScope string
+
+const (
+	ProfileReadScope          TokenScope = "profile:read"
+	ProfileWriteScope         TokenScope = "profile:write"
+	JobSatisfactionReadScope  TokenScope = "job_satisfaction:read"
+	JobSatisfactionWriteScope TokenScope = "job_satisfaction:write"
+	ChatReadScope             TokenScope = "chat:read"
+	ChatWriteScope            TokenScope = "chat:write"
+)
+
+// IsValid - 스코프 유효성 검사
+func (s TokenScope) IsValid() bool {
+	switch s {
+	case ProfileReadScope, ProfileWriteScope,
+		JobSatisfactionReadScope, JobSatisfactionWriteScope,
+		ChatReadScope, ChatWriteScope:
+		return true
+	}
+	return false
+}
+
+// String - 문자열 변환
+func (s TokenScope) String() string {
+	return string(s)
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	FailedLoginAuditPrefix = "LOGIN_FAIL"
+)
+
+// LoginThrottle은 계정(email:) 또는 IP(ip:) 단위의 로그인 실패 카운터입니다.
+// 여러 인스턴스에서 공유되도록 DB에 저장합니다.
+type LoginThrottle struct {
+	Key           string     `json:"key" gorm:"primaryKey;type:varchar(320)"`
+	FailureCount  int        `json:"failureCount" gorm:"not null;default:0"`
+	LastFailureAt time.Time  `json:"lastFailureAt" gorm:"not null"`
+	LockedUntil   *time.Time `json:"lockedUntil"`
+}
+
+// FailedLoginAudit는 실패한 로그인 시도 하나를 기록하는 감사 로그입니다
+type FailedLoginAudit struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	Email     string    `json:"email" gorm:"index;not null"`
+	UserID    *string   `json:"userId" gorm:"type:varchar(100);index"`
+	IPAddress string    `json:"ipAddress" gorm:"type:varchar(64);index"`
+	UserAgent string    `json:"userAgent"`
+	Reason    string    `json:"reason" gorm:"type:varchar(30);not null"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (a *FailedLoginAudit) BeforeCreate(tx *gorm.DB) error {
+	a.ID = utils.GenerateID(FailedLoginAuditPrefix)
+	return nil
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PersonalAccessTokenPrefix = "USR_PAT"
+)
+
+// PersonalAccessToken은 스크립트나 외부 연동에서 사용하는 사용자 발급 토큰입니다.
+// 토큰 원문은 발급 시 한 번만 보여주고 해시만 저장합니다.
+type PersonalAccessToken struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID     string     `json:"userId" gorm:"type:varchar(100);index;not null"`
+	Name       string     `json:"name" gorm:"not null"`
+	TokenHash  string     `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	TokenHint  string     `json:"tokenHint" gorm:"type:varchar(20)"` // 목록에서 토큰을 구분하기 위한 앞부분
+	Scopes     string     `json:"-" gorm:"not null"`                 // 콤마 구분
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func (t *PersonalAccessToken) BeforeCreate(tx *gorm.DB) error {
+	t.ID = utils.GenerateID(PersonalAccessTokenPrefix)
+	return nil
+}
+
+// ScopeList는 토큰의 스코프 목록을 반환합니다
+func (t *PersonalAccessToken) ScopeList() []string {
+	if t.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(t.Scopes, ",")
+}
+
+// IsActive는 토큰이 폐기되지 않았고 만료되지 않았는지 확인합니다
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && (t.ExpiresAt == nil || now.Before(*t.ExpiresAt))
+}
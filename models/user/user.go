@@ -1,7 +1,9 @@
 package user
 
 import (
+	"career-log-be/models/user/enums"
 	"career-log-be/utils"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -12,15 +14,49 @@ const (
 )
 
 type User struct {
-	ID        string `gorm:"primaryKey;type:varchar(100)"`
-	Email     string `gorm:"uniqueIndex;not null"`
-	Password  string `gorm:"not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID              string `gorm:"primaryKey;type:varchar(100)"`
+	Email           string `gorm:"uniqueIndex;not null"`
+	Password        string `gorm:"not null"`
+	EmailVerifiedAt *time.Time
+	Roles           string     `gorm:"type:varchar(255);not null;default:'user'"` // 쉼표로 구분된 역할 목록
+	PurgeAfter      *time.Time `gorm:"index"`                                     // 탈퇴 요청 계정의 영구 삭제 예정 시각 (이전까지 복구 가능)
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+// HasPassword는 비밀번호 로그인이 가능한 계정인지 확인합니다. 외부 로그인으로만 가입한 계정은 비밀번호가 없습니다
+func (user *User) HasPassword() bool {
+	return user.Password != ""
+}
+
+// IsEmailVerified는 이메일 인증이 완료되었는지 확인합니다
+func (user *User) IsEmailVerified() bool {
+	return user.EmailVerifiedAt != nil
+}
+
+// RoleList는 사용자에게 부여된 역할 목록을 반환합니다
+func (user *User) RoleList() []string {
+	if user.Roles == "" {
+		return []string{enums.UserRole.String()}
+	}
+	return strings.Split(user.Roles, ",")
+}
+
+// HasRole은 사용자에게 주어진 역할이 있는지 확인합니다
+func (user *User) HasRole(role enums.Role) bool {
+	for _, granted := range user.RoleList() {
+		if granted == role.String() {
+			return true
+		}
+	}
+	return false
 }
 
 func (user *User) BeforeCreate(tx *gorm.DB) error {
 	user.ID = utils.GenerateID(UserPrefix)
+	if user.Roles == "" {
+		user.Roles = enums.UserRole.String()
+	}
 	return nil
 }
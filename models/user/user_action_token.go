@@ -0,0 +1,30 @@
+package user
+
+import (
+	"career-log-be/models/user/enums"
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	UserActionTokenPrefix = "USR_ACT"
+)
+
+// UserActionToken은 비밀번호 재설정, 이메일 인증 등에 쓰이는 일회용 토큰입니다.
+// 토큰 원문은 저장하지 않고 해시만 저장합니다.
+type UserActionToken struct {
+	ID        string             `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID    string             `json:"userId" gorm:"type:varchar(100);index;not null"`
+	Purpose   enums.TokenPurpose `json:"purpose" gorm:"type:varchar(30);not null"`
+	TokenHash string             `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time          `json:"expiresAt" gorm:"not null"`
+	UsedAt    *time.Time         `json:"usedAt"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+func (t *UserActionToken) BeforeCreate(tx *gorm.DB) error {
+	t.ID = utils.GenerateID(UserActionTokenPrefix)
+	return nil
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"career-log-be/models/user/enums"
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	UserDataExportPrefix = "USR_EXP"
+)
+
+// UserDataExport는 사용자 데이터 내보내기(ZIP) 작업입니다. 파일은 UserDataExportChunk에 나누어 저장됩니다
+type UserDataExport struct {
+	ID          string             `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID      string             `json:"userId" gorm:"type:varchar(100);index;not null"`
+	Status      enums.ExportStatus `json:"status" gorm:"type:varchar(20);index;not null"`
+	FileSize    int64              `json:"fileSize"`
+	Error       string             `json:"error,omitempty"`
+	CompletedAt *time.Time         `json:"completedAt"`
+	ExpiresAt   *time.Time         `json:"expiresAt"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+func (e *UserDataExport) BeforeCreate(tx *gorm.DB) error {
+	e.ID = utils.GenerateID(UserDataExportPrefix)
+	return nil
+}
+
+// IsDownloadable은 내보내기 파일을 내려받을 수 있는 상태인지 확인합니다
+func (e *UserDataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == enums.ReadyExportStatus && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
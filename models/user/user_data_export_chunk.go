@@ -0,0 +1,13 @@
+package user
+
+import "time"
+
+// UserDataExportChunk는 완료된 내보내기 ZIP 파일의 한 조각입니다.
+// 파일을 순서(Seq)대로 나누어 데이터베이스에 저장하므로 어느 서버에서든 조각 단위로 읽어 내려받을 수 있고,
+// 만료·영구 삭제 시 행과 함께 삭제됩니다.
+type UserDataExportChunk struct {
+	ExportID  string `gorm:"primaryKey;type:varchar(100)"`
+	Seq       int    `gorm:"primaryKey;autoIncrement:false"`
+	Data      []byte `gorm:"type:bytea;not null"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	UserIdentityPrefix = "USR_IDT"
+)
+
+// UserIdentity는 외부 로그인 제공자(Google, GitHub 등)의 계정과 사용자를 연결합니다.
+// 한 사용자는 여러 제공자의 계정을 연결할 수 있습니다.
+type UserIdentity struct {
+	ID            string    `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID        string    `json:"userId" gorm:"type:varchar(100);index;not null"`
+	Provider      string    `json:"provider" gorm:"type:varchar(50);uniqueIndex:idx_identity_provider_subject;not null"`
+	Subject       string    `json:"subject" gorm:"type:varchar(255);uniqueIndex:idx_identity_provider_subject;not null"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"emailVerified"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+func (i *UserIdentity) BeforeCreate(tx *gorm.DB) error {
+	i.ID = utils.GenerateID(UserIdentityPrefix)
+	return nil
+}
+
+// OAuthLoginState는 인가 요청과 콜백 사이에 유지해야 하는 state, PKCE verifier, nonce입니다.
+// 여러 인스턴스에서 콜백을 처리할 수 있도록 DB에 저장합니다.
+type OAuthLoginState struct {
+	StateHash    string    `gorm:"primaryKey;type:varchar(64)"`
+	Provider     string    `gorm:"type:varchar(50);not null"`
+	CodeVerifier string    `gorm:"not null"`
+	Nonce        string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"index;not null"`
+	CreatedAt    time.Time
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RecoveryCodePrefix = "USR_RCV"
+)
+
+// UserMFA는 사용자의 TOTP 2단계 인증 설정입니다. EnabledAt이 nil이면 등록 확인 전 상태입니다
+type UserMFA struct {
+	UserID       string     `json:"userId" gorm:"primaryKey;type:varchar(100)"`
+	Secret       string     `json:"-" gorm:"not null"`
+	EnabledAt    *time.Time `json:"enabledAt"`
+	LastUsedStep int64      `json:"-"` // 같은 코드 재사용 방지를 위한 마지막 사용 타임 스텝
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// IsEnabled는 2단계 인증이 활성화되었는지 확인합니다
+func (m *UserMFA) IsEnabled() bool {
+	return m.EnabledAt != nil
+}
+
+// UserRecoveryCode는 인증 앱을 사용할 수 없을 때 쓰는 일회용 복구 코드입니다. 해시만 저장합니다
+type UserRecoveryCode struct {
+	ID        string     `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID    string     `json:"userId" gorm:"type:varchar(100);index;not null"`
+	CodeHash  string     `json:"-" gorm:"type:varchar(64);not null"`
+	UsedAt    *time.Time `json:"usedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func (c *UserRecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	c.ID = utils.GenerateID(RecoveryCodePrefix)
+	return nil
+}
@@ -1,14 +1,35 @@
 package user
 
 import (
+	"career-log-be/models/user/enums"
+	"career-log-be/utils/timezone"
 	"time"
 )
 
 type UserProfile struct {
-	ID           string    `json:"id" gorm:"primaryKey"`
-	Name         string    `json:"name" gorm:"not null"`
-	Nickname     string    `json:"nickname"`
-	Organization string    `json:"organization" gorm:"not null"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           string          `json:"id" gorm:"primaryKey"`
+	Name         string          `json:"name" gorm:"not null"`
+	Nickname     string          `json:"nickname"`
+	Organization string          `json:"organization" gorm:"not null"`
+	JobTitle     string          `json:"job_title"`
+	Team         string          `json:"team"`
+	Seniority    enums.Seniority `json:"seniority" gorm:"type:varchar(20);not null;default:''"`
+	StartDate    *time.Time      `json:"start_date" gorm:"type:date"` // 현재 조직 입사일
+	Industry     string          `json:"industry"`
+	Timezone     string          `json:"timezone" gorm:"type:varchar(64);not null;default:'Asia/Seoul'"` // IANA 시간대 (일일 대화, 분석의 날짜 기준)
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// DisplayName은 사용자를 부를 때 사용할 이름을 반환합니다. 닉네임이 없으면 이름을 사용합니다
+func (profile *UserProfile) DisplayName() string {
+	if profile.Nickname != "" {
+		return profile.Nickname
+	}
+	return profile.Name
+}
+
+// Location은 사용자 시간대를 반환합니다. 설정되지 않았거나 잘못된 값이면 기본 시간대를 사용합니다
+func (profile *UserProfile) Location() *time.Location {
+	return timezone.LoadOrDefault(profile.Timezone)
 }
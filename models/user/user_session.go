@@ -0,0 +1,61 @@
+package user
+
+import (
+	"career-log-be/utils"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	UserSessionPrefix  = "USR_SES"
+	RefreshTokenPrefix = "USR_RFT"
+)
+
+// UserSession은 로그인 단위의 세션(리프레시 토큰 패밀리)입니다
+type UserSession struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	UserID     string     `json:"userId" gorm:"type:varchar(100);index;not null"`
+	UserAgent  string     `json:"userAgent"`
+	IPAddress  string     `json:"ipAddress" gorm:"type:varchar(64)"`
+	AMR        string     `json:"-" gorm:"column:amr;type:varchar(100)"` // 로그인 시 거친 인증 방식 (콤마 구분)
+	ExpiresAt  time.Time  `json:"expiresAt" gorm:"not null"`
+	LastSeenAt time.Time  `json:"lastSeenAt"`
+	RevokedAt  *time.Time `json:"revokedAt" gorm:"index"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func (s *UserSession) BeforeCreate(tx *gorm.DB) error {
+	s.ID = utils.GenerateID(UserSessionPrefix)
+	return nil
+}
+
+// AuthMethods는 세션 로그인 시 거친 인증 방식 목록을 반환합니다
+func (s *UserSession) AuthMethods() []string {
+	if s.AMR == "" {
+		return nil
+	}
+	return strings.Split(s.AMR, ",")
+}
+
+// IsActive는 세션이 폐기되지 않았고 만료되지 않았는지 확인합니다
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// UserRefreshToken은 세션에 속한 리프레시 토큰입니다. 토큰 원문은 저장하지 않고 해시만 저장합니다
+type UserRefreshToken struct {
+	ID        string     `json:"id" gorm:"primaryKey;type:varchar(100)"`
+	SessionID string     `json:"sessionId" gorm:"type:varchar(100);index;not null"`
+	TokenHash string     `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time  `json:"expiresAt" gorm:"not null"`
+	UsedAt    *time.Time `json:"usedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func (t *UserRefreshToken) BeforeCreate(tx *gorm.DB) error {
+	t.ID = utils.GenerateID(RefreshTokenPrefix)
+	return nil
+}
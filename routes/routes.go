@@ -2,11 +2,16 @@ package routes
 
 import (
 	v1 "career-log-be/routes/v1"
+	authService "career-log-be/services/auth"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 func SetupRoutes(app *fiber.App) {
+	// Well-known routes
+	wellKnown := app.Group("/.well-known")
+	wellKnown.Get("/jwks.json", authService.HandleJWKS())
+
 	// API group
 	api := app.Group("/api")
 
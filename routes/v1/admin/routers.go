@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"career-log-be/middleware"
+	"career-log-be/models/user/enums"
+	admin "career-log-be/services/admin"
+	chat "career-log-be/services/note/chat"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupRoutes(router fiber.Router) {
+	adminRouter := router.Group("/admin")
+
+	// 관리자 전용 라우트 그룹 (라우트마다 필요한 권한을 확인)
+	protected := adminRouter.Use(middleware.AuthMiddleware())
+
+	// 예약 작업 실행 기록 조회
+	protected.Get("/job-runs", middleware.RequirePermission(enums.ViewJobRunsPermission), admin.HandleListJobRuns())
+
+	// LLM 사용량/비용 보고서
+	protected.Get("/usage", middleware.RequirePermission(enums.ViewUsagePermission), admin.HandleGetUsageReport())
+
+	// 기간 지정 채팅 분석 (백필)
+	protected.Post("/chat-analysis/backfill", middleware.RequirePermission(enums.RunAnalysisPermission), chat.HandleBackfillChatAnalysis)
+}
@@ -3,6 +3,7 @@
 package auth
 
 import (
+	"career-log-be/middleware"
 	authService "career-log-be/services/auth"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,4 +17,36 @@ func SetupRoutes(router fiber.Router) {
 
 	// Login route
 	auth.Post("/login", authService.HandleLogin())
+
+	// Refresh token rotation route
+	auth.Post("/refresh", authService.HandleRefresh())
+
+	// Logout route
+	auth.Post("/logout", authService.HandleLogout())
+
+	// Password reset routes
+	auth.Post("/password/forgot", authService.HandleForgotPassword())
+	auth.Post("/password/reset", authService.HandleResetPassword())
+
+	// Email verification routes
+	auth.Post("/verify-email", authService.HandleVerifyEmail())
+	auth.Post("/verify-email/resend", middleware.AuthMiddleware(), middleware.RequireInteractiveSession(), authService.HandleResendVerificationEmail())
+
+	// Account restore route (within the deletion grace period)
+	auth.Post("/account/restore", authService.HandleRestoreAccount())
+
+	// Two-factor login route
+	auth.Post("/login/mfa", authService.HandleLoginMFA())
+
+	// External (OIDC/OAuth2) login routes
+	auth.Get("/oauth/providers", authService.HandleListOAuthProviders())
+	auth.Get("/oauth/:provider/authorize", authService.HandleOAuthAuthorize())
+	auth.Get("/oauth/:provider/callback", authService.HandleOAuthCallback())
+
+	// Two-factor management routes
+	mfa := auth.Group("/mfa", middleware.AuthMiddleware(), middleware.RequireInteractiveSession())
+	mfa.Post("/totp/enroll", authService.HandleEnrollTOTP())
+	mfa.Post("/totp/confirm", authService.HandleConfirmTOTP())
+	mfa.Delete("/totp", middleware.RequireMFA(), authService.HandleDisableTOTP())
+	mfa.Post("/recovery-codes", middleware.RequireMFA(), authService.HandleRegenerateRecoveryCodes())
 }
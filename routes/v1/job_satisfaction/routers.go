@@ -2,6 +2,7 @@ package job_satisfaction
 
 import (
 	"career-log-be/middleware"
+	"career-log-be/models/user/enums"
 	job_satisfaction "career-log-be/services/job_satisfaction"
 
 	"github.com/gofiber/fiber/v2"
@@ -11,7 +12,7 @@ func SetupRoutes(router fiber.Router) {
 	jobSatisfactionRouter := router.Group("/job-satisfaction")
 
 	// 보호된 라우트 그룹
-	protected := jobSatisfactionRouter.Use(middleware.AuthMiddleware())
+	protected := jobSatisfactionRouter.Use(middleware.AuthMiddleware(), middleware.RequireScope("job_satisfaction"))
 
 	// 직무 만족도 중요도 생성
 	protected.Post("/importance", job_satisfaction.HandleCreateJobSatisfactionImportance())
@@ -21,4 +22,16 @@ func SetupRoutes(router fiber.Router) {
 
 	// 현재 직무 만족도 조회
 	protected.Get("/current", job_satisfaction.HandleGetCurrentJobSatisfaction())
+
+	// 기간별 직무 만족도 추이 조회
+	protected.Get("/history", job_satisfaction.HandleGetJobSatisfactionHistory())
+
+	// 직무 만족도 변경 이벤트 목록 조회
+	protected.Get("/events", job_satisfaction.HandleListJobSatisfactionEvents())
+
+	// 만족도 변경 요청 처리 상태 조회
+	protected.Get("/requests/:id", job_satisfaction.HandleGetJobSatisfactionRequest())
+
+	// 이벤트 로그로 직무 만족도 재계산 (admin only)
+	protected.Post("/replay", middleware.RequirePermission(enums.ReplayProjectionsPermission), job_satisfaction.HandleReplayJobSatisfaction())
 }
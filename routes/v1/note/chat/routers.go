@@ -2,6 +2,7 @@ package chat
 
 import (
 	"career-log-be/middleware"
+	"career-log-be/models/user/enums"
 	chat "career-log-be/services/note/chat"
 
 	"github.com/gofiber/fiber/v2"
@@ -9,19 +10,19 @@ import (
 
 func SetupRoutes(router fiber.Router) {
 	chatRouter := router.Group("/chat")
-	protected := chatRouter.Use(middleware.AuthMiddleware())
+	protected := chatRouter.Use(middleware.AuthMiddleware(), middleware.RequireVerifiedEmail(), middleware.RequireScope("chat"))
 
 	// Get all pre-chats
 	protected.Get("/pre-chats", chat.HandleListPreChats)
 
-	// Create new pre-chat
-	protected.Post("/pre-chats", chat.HandleCreatePreChat)
+	// Create new pre-chat (admin only)
+	protected.Post("/pre-chats", middleware.RequirePermission(enums.ManagePreChatsPermission), chat.HandleCreatePreChat)
 
 	// Create new chat
 	protected.Post("/create", chat.HandleCreateChat)
 
-	// Analyze daily chat manually (Scheduler Test API)
-	protected.Post("/analyze-daily", chat.HandleAnalyzeDailyChat)
+	// Analyze daily chat manually (Scheduler Test API, admin only)
+	protected.Post("/analyze-daily", middleware.RequirePermission(enums.RunAnalysisPermission), chat.HandleAnalyzeDailyChat)
 
 	// Get chat by ID
 	protected.Get("/:id", chat.HandleGetChat)
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"career-log-be/routes/v1/admin"
 	"career-log-be/routes/v1/auth"
 	"career-log-be/routes/v1/job_satisfaction"
 	"career-log-be/routes/v1/note"
@@ -23,4 +24,7 @@ func SetupRoutes(router fiber.Router) {
 
 	// Setup note routes
 	note.SetupRoutes(v1)
+
+	// Setup admin routes
+	admin.SetupRoutes(v1)
 }
@@ -13,12 +13,37 @@ func SetupRoutes(router fiber.Router) {
 	// 보호된 라우트 그룹
 	protected := userRouter.Use(middleware.AuthMiddleware())
 
+	// 개인 액세스 토큰 관리 (로그인 세션에서만 가능)
+	tokens := protected.Group("/tokens", middleware.RequireInteractiveSession())
+	tokens.Post("/", user.HandleCreatePersonalAccessToken())
+	tokens.Get("/", user.HandleListPersonalAccessTokens())
+	tokens.Delete("/:id", user.HandleRevokePersonalAccessToken())
+
+	// 로그인 세션(기기) 관리
+	sessions := protected.Group("/sessions", middleware.RequireInteractiveSession())
+	sessions.Get("/", user.HandleListSessions())
+	sessions.Delete("/", user.HandleRevokeOtherSessions())
+	sessions.Delete("/:id", user.HandleRevokeSession())
+
+	// 개인 데이터 내보내기 (ZIP)
+	protected.Post("/export", middleware.RequireInteractiveSession(), user.HandleRequestDataExport())
+	protected.Get("/export/:id", middleware.RequireInteractiveSession(), user.HandleGetDataExport())
+
+	// 회원 탈퇴 (복구 기간 후 영구 삭제)
+	protected.Delete("/account", middleware.RequireInteractiveSession(), user.HandleDeleteAccount())
+
+	// LLM 토큰 사용량 및 한도 조회
+	protected.Get("/usage", middleware.RequireScope("chat"), user.HandleGetUsage())
+
 	// 프로필 생성
-	protected.Post("/profile", user.HandleCreateUserProfile())
+	protected.Post("/profile", middleware.RequireScope("profile"), user.HandleCreateUserProfile())
+
+	// 프로필 조회
+	protected.Get("/profile", middleware.RequireScope("profile"), user.HandleGetUserProfile())
 
-	// // 프로필 조회
-	// protected.Get("/profile", userService.HandleGetProfile())
+	// 프로필 전체 수정
+	protected.Put("/profile", middleware.RequireScope("profile"), user.HandleUpdateUserProfile())
 
-	// // 프로필 업데이트
-	// protected.Put("/profile", userService.HandleUpdateProfile())
+	// 프로필 일부 수정
+	protected.Patch("/profile", middleware.RequireScope("profile"), user.HandlePatchUserProfile())
 }
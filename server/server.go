@@ -0,0 +1,146 @@
+// Package server assembles the Fiber application from its dependencies.
+// It is shared by the API server and the end-to-end tests.
+package server
+
+import (
+	"career-log-be/middleware"
+	job_satisfaction "career-log-be/models/job_satisfaction"
+	"career-log-be/models/note/chat"
+	scheduler_model "career-log-be/models/scheduler"
+	"career-log-be/models/usage"
+	user "career-log-be/models/user"
+	"career-log-be/routes"
+	"career-log-be/services/auth/core/oauth"
+	"career-log-be/services/auth/core/session"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/services/job_satisfaction/core/event"
+	"career-log-be/services/job_satisfaction/core/replay"
+	job_satisfaction_scheduler "career-log-be/services/job_satisfaction/scheduler"
+	chat_scheduler "career-log-be/services/note/chat/scheduler"
+	"career-log-be/services/usage/core/ledger"
+	"career-log-be/utils/jwt"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/mail"
+	"fmt"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// Dependencies 외부 서비스에 연결하는 의존성입니다. e2e 테스트에서는 가짜 구현체로 교체합니다
+type Dependencies struct {
+	LLM  llm.LLMProvider
+	Mail mail.Sender
+}
+
+// Context 앱과 함께 생성되어 서버 실행 중 공유되는 구성 요소입니다
+type Context struct {
+	DB           *gorm.DB
+	JWT          *jwt.JWTUtils
+	ChatAnalyzer *chat_scheduler.ChatAnalyzeScheduler
+}
+
+// Migrate 데이터베이스 스키마를 최신 상태로 맞춥니다
+func Migrate(db *gorm.DB) error {
+	// 이벤트 유일성 제약 추가 전, 같은 출처의 중복 분석 이벤트 정리
+	dedupedUserIDs, err := event.DeduplicateSourceEvents(db)
+	if err != nil {
+		return fmt.Errorf("could not deduplicate job satisfaction events: %v", err)
+	}
+
+	// 이메일 인증 도입 전에 가입한 사용자는 인증된 것으로 처리 (채팅 이용 제한 방지)
+	grandfathered, err := verification.GrandfatherExistingUsers(db)
+	if err != nil {
+		return fmt.Errorf("could not mark existing users as verified: %v", err)
+	}
+	if grandfathered > 0 {
+		log.Printf("Marked %d existing users as email-verified", grandfathered)
+	}
+
+	// Auto Migrate
+	if err := db.AutoMigrate(
+		&user.User{},
+		&user.UserProfile{},
+		&user.UserSession{},
+		&user.UserRefreshToken{},
+		&user.UserActionToken{},
+		&user.LoginThrottle{},
+		&user.FailedLoginAudit{},
+		&user.UserMFA{},
+		&user.UserRecoveryCode{},
+		&user.UserIdentity{},
+		&user.OAuthLoginState{},
+		&user.PersonalAccessToken{},
+		&user.AccountErasureReceipt{},
+		&user.UserDataExport{},
+		&user.UserDataExportChunk{},
+		&job_satisfaction.UserJobSatisfactionImportance{},
+		&job_satisfaction.UserJobSatisfaction{},
+		&job_satisfaction.JobSatisfactionUpdateEvent{},
+		&job_satisfaction.JobSatisfactionOutbox{},
+		&chat.ChatSet{},
+		&chat.PreChat{},
+		&chat.ChatAnalysisAttempt{},
+		&scheduler_model.ScheduledJobRun{},
+		&usage.LLMUsage{},
+		&usage.LLMQuotaReservation{},
+	); err != nil {
+		return fmt.Errorf("could not migrate database: %v", err)
+	}
+
+	// 중복 이벤트가 삭제된 사용자의 직무 만족도를 이벤트 로그로 다시 계산
+	for _, userID := range dedupedUserIDs {
+		if _, err := replay.Run(db, replay.Options{UserID: userID}); err != nil {
+			return fmt.Errorf("could not rebuild job satisfaction for %s: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// New 준비된 데이터베이스와 주어진 의존성으로 Fiber 앱을 구성합니다.
+// 요청 처리에 필요한 아웃박스 워커는 함께 시작하며, 예약 작업(cron)은 시작하지 않습니다.
+func New(db *gorm.DB, deps Dependencies) (*fiber.App, *Context, error) {
+	// JWT 유틸리티 초기화
+	jwtUtils, err := jwt.NewJWTUtils()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not initialize JWT utils: %v", err)
+	}
+
+	// 모든 LLM 호출의 토큰 사용량과 예상 비용을 원장에 기록
+	llmProvider := llm.NewMeteredProvider(deps.LLM, ledger.NewRecorder(db, ledger.PricingFromEnv()))
+
+	// 사용자별 상담 대화 토큰 한도
+	quota := ledger.NewQuotaLimiter(db, ledger.QuotaConfigFromEnv())
+
+	// 채팅 분석기 초기화 (예약 분석과 관리자 수동 실행이 워커 풀과 요청 한도를 공유)
+	chatAnalyzer := chat_scheduler.NewChatAnalyzeScheduler(db, llmProvider)
+
+	// 세션 폐기 여부 검증 등록
+	jwtUtils.SetSessionValidator(session.NewValidator(db))
+
+	// Fiber 앱 생성 (에러 핸들러 등록)
+	app := fiber.New(fiber.Config{
+		ErrorHandler: middleware.ErrorHandler(),
+	})
+
+	// 미들웨어 설정
+	app.Use(middleware.DatabaseMiddleware(db))
+	app.Use(middleware.JWTMiddleware(jwtUtils))
+	app.Use(middleware.LLMMiddleware(llmProvider))
+	app.Use(middleware.QuotaMiddleware(quota))
+	app.Use(middleware.ChatAnalyzerMiddleware(chatAnalyzer))
+	app.Use(middleware.MailMiddleware(deps.Mail))
+	app.Use(middleware.OAuthMiddleware(oauth.NewRegistryFromEnv()))
+
+	// 라우터 설정
+	routes.SetupRoutes(app)
+
+	// 직무 만족도 아웃박스 워커 초기화
+	if err := job_satisfaction_scheduler.InitOutboxWorker(app, db); err != nil {
+		return nil, nil, fmt.Errorf("could not initialize outbox worker: %v", err)
+	}
+
+	return app, &Context{DB: db, JWT: jwtUtils, ChatAnalyzer: chatAnalyzer}, nil
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/usage/core/ledger"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// usageReportDateLayout은 보고서 기간 입력 형식입니다 (UTC 기준)
+const usageReportDateLayout = "2006-01-02"
+
+// usageReportDefaultDays는 기간을 지정하지 않았을 때 집계하는 일수입니다
+const usageReportDefaultDays = 30
+
+type UsageReportQuery struct {
+	From    string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To      string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+	GroupBy string `query:"groupBy" validate:"omitempty,oneof=user model task provider"`
+	Limit   int    `query:"limit" validate:"omitempty,min=1,max=500"`
+}
+
+type UsageReportResponse struct {
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	GroupBy string              `json:"groupBy"`
+	Total   ledger.Summary      `json:"total"`
+	Groups  []ledger.GroupUsage `json:"groups"`
+}
+
+// HandleGetUsageReport는 전체 사용자의 LLM 사용량과 예상 비용을 기준별로 집계하여 반환합니다.
+// 기간은 UTC 날짜 기준이며 from/to 날짜를 모두 포함합니다.
+func HandleGetUsageReport() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+
+		query := new(UsageReportQuery)
+		if err := c.QueryParser(query); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid query parameters",
+			)
+		}
+
+		validate := validator.New()
+		if err := validate.Struct(query); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		if query.GroupBy == "" {
+			query.GroupBy = "user"
+		}
+		if query.Limit == 0 {
+			query.Limit = 50
+		}
+
+		// 기본값: 오늘까지 최근 30일
+		now := time.Now().UTC()
+		toDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if query.To != "" {
+			toDate, _ = time.Parse(usageReportDateLayout, query.To)
+		}
+		fromDate := toDate.AddDate(0, 0, -(usageReportDefaultDays - 1))
+		if query.From != "" {
+			fromDate, _ = time.Parse(usageReportDateLayout, query.From)
+		}
+		if fromDate.After(toDate) {
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				"from must not be after to",
+			)
+		}
+
+		total, groups, err := ledger.Report(db, fromDate, toDate.AddDate(0, 0, 1), query.GroupBy, query.Limit)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, UsageReportResponse{
+			From:    fromDate.Format(usageReportDateLayout),
+			To:      toDate.Format(usageReportDateLayout),
+			GroupBy: query.GroupBy,
+			Total:   total,
+			Groups:  groups,
+		})
+	}
+}
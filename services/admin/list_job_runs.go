@@ -0,0 +1,52 @@
+package admin
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ListJobRunsQuery struct {
+	Job   string `query:"job"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// HandleListJobRuns는 예약 작업의 최근 실행 기록을 조회하는 핸들러입니다
+func HandleListJobRuns() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+
+		query := new(ListJobRunsQuery)
+		if err := c.QueryParser(query); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid query parameters",
+			)
+		}
+
+		validate := validator.New()
+		if err := validate.Struct(query); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		if query.Limit == 0 {
+			query.Limit = 20
+		}
+
+		runs, err := jobrun.List(db, query.Job, query.Limit)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, runs)
+	}
+}
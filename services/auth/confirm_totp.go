@@ -0,0 +1,51 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ConfirmTOTPInput struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// HandleConfirmTOTP는 인증 앱의 첫 코드를 확인해 2단계 인증을 활성화하고 복구 코드를 한 번만 보여줍니다
+func HandleConfirmTOTP() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		input := new(ConfirmTOTPInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		codes, err := mfa.Confirm(db, userID, input.Code)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, RecoveryCodesResponse{RecoveryCodes: codes})
+	}
+}
@@ -0,0 +1,266 @@
+package mfa
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/token"
+	"career-log-be/utils/totp"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// recoveryCodeCount는 한 번에 발급하는 복구 코드 개수입니다
+	recoveryCodeCount = 10
+	// validationSkew는 TOTP 검증 시 허용하는 앞뒤 타임 스텝 수입니다
+	validationSkew = 1
+)
+
+// Authentication Method Reference 값 (RFC 8176)
+const (
+	AMRPassword  = "pwd"
+	AMROTP       = "otp"
+	AMRMFA       = "mfa"
+	AMRFederated = "fed" // 외부 제공자(OIDC/OAuth2) 로그인
+)
+
+// Issuer는 인증 앱에 표시될 발급자 이름을 반환합니다
+func Issuer() string {
+	if issuer := os.Getenv("MFA_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return "Career Log"
+}
+
+// Find는 사용자의 MFA 설정을 조회합니다. 설정이 없으면 nil을 반환합니다
+func Find(db *gorm.DB, userID string) (*user.UserMFA, error) {
+	var setting user.UserMFA
+	result := db.Where("user_id = ?", userID).First(&setting)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query MFA settings", result.Error)
+	}
+	return &setting, nil
+}
+
+// IsEnabled는 사용자가 2단계 인증을 활성화했는지 확인합니다
+func IsEnabled(db *gorm.DB, userID string) (bool, error) {
+	setting, err := Find(db, userID)
+	if err != nil {
+		return false, err
+	}
+	return setting != nil && setting.IsEnabled(), nil
+}
+
+// Enroll은 새 TOTP 비밀키를 발급합니다. 확인(Confirm) 전까지는 로그인에 적용되지 않습니다
+func Enroll(db *gorm.DB, target *user.User) (secret string, uri string, err error) {
+	existing, err := Find(db, target.ID)
+	if err != nil {
+		return "", "", err
+	}
+	if existing != nil && existing.IsEnabled() {
+		return "", "", errors.NewConflictError(errors.ErrorCodeResourceExists, "Two-factor authentication is already enabled")
+	}
+
+	secret, err = totp.GenerateSecret()
+	if err != nil {
+		return "", "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate TOTP secret", err)
+	}
+
+	setting := user.UserMFA{UserID: target.ID, Secret: secret}
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled_at", "last_used_step", "updated_at"}),
+	}).Create(&setting).Error; err != nil {
+		return "", "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store TOTP secret", err)
+	}
+
+	return secret, totp.URI(Issuer(), target.Email, secret), nil
+}
+
+// Confirm은 첫 코드를 검증해 2단계 인증을 활성화하고 복구 코드를 발급합니다
+func Confirm(db *gorm.DB, userID, code string) ([]string, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	var setting user.UserMFA
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&setting)
+	if result.Error != nil {
+		tx.Rollback()
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "TOTP enrollment not found")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query MFA settings", result.Error)
+	}
+	if setting.IsEnabled() {
+		tx.Rollback()
+		return nil, errors.NewConflictError(errors.ErrorCodeResourceExists, "Two-factor authentication is already enabled")
+	}
+
+	step, ok := totp.Validate(setting.Secret, code, time.Now(), validationSkew)
+	if !ok {
+		tx.Rollback()
+		return nil, errors.NewBadRequestError(errors.ErrorCodeInvalidMFACode, "Invalid verification code")
+	}
+
+	now := time.Now()
+	if err := tx.Model(&setting).Updates(map[string]interface{}{
+		"enabled_at":     now,
+		"last_used_step": step,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to enable two-factor authentication", err)
+	}
+
+	codes, err := replaceRecoveryCodes(tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit two-factor authentication", err)
+	}
+
+	return codes, nil
+}
+
+// Verify는 로그인 시 TOTP 코드를 검증합니다. 이미 사용한 타임 스텝의 코드는 거부합니다
+func Verify(db *gorm.DB, userID, code string) (bool, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	var setting user.UserMFA
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&setting)
+	if result.Error != nil {
+		tx.Rollback()
+		if result.Error == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query MFA settings", result.Error)
+	}
+
+	step, ok := totp.Validate(setting.Secret, code, time.Now(), validationSkew)
+	if !setting.IsEnabled() || !ok || step <= setting.LastUsedStep {
+		tx.Rollback()
+		return false, nil
+	}
+
+	if err := tx.Model(&setting).Update("last_used_step", step).Error; err != nil {
+		tx.Rollback()
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to update MFA settings", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit MFA verification", err)
+	}
+
+	return true, nil
+}
+
+// UseRecoveryCode는 복구 코드를 검증하고 사용 처리합니다
+func UseRecoveryCode(db *gorm.DB, userID, code string) (bool, error) {
+	result := db.Model(&user.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, token.HashToken(normalizeRecoveryCode(code))).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to use recovery code", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RegenerateRecoveryCodes는 기존 복구 코드를 모두 폐기하고 새로 발급합니다
+func RegenerateRecoveryCodes(db *gorm.DB, userID string) ([]string, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	codes, err := replaceRecoveryCodes(tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit recovery codes", err)
+	}
+
+	return codes, nil
+}
+
+// Disable은 2단계 인증을 해제하고 복구 코드를 삭제합니다
+func Disable(db *gorm.DB, userID string) error {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&user.UserRecoveryCode{}).Error; err != nil {
+		tx.Rollback()
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to delete recovery codes", err)
+	}
+
+	if err := tx.Where("user_id = ?", userID).Delete(&user.UserMFA{}).Error; err != nil {
+		tx.Rollback()
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to disable two-factor authentication", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit MFA removal", err)
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID string) ([]string, error) {
+	if err := tx.Where("user_id = ?", userID).Delete(&user.UserRecoveryCode{}).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to delete recovery codes", err)
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	records := make([]user.UserRecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate recovery code", err)
+		}
+		codes = append(codes, code)
+		records = append(records, user.UserRecoveryCode{
+			UserID:   userID,
+			CodeHash: token.HashToken(normalizeRecoveryCode(code)),
+		})
+	}
+
+	if err := tx.Create(&records).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store recovery codes", err)
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode는 XXXXX-XXXXX 형식의 복구 코드를 생성합니다
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 7)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %v", err)
+	}
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)[:10]
+	return encoded[:5] + "-" + encoded[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/mail"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ResolveUser는 외부 계정에 연결된 사용자를 찾습니다.
+// 연결된 사용자가 없으면 제공자가 인증한 이메일로 기존 계정에 연결하거나 새 계정을 만듭니다.
+func ResolveUser(db *gorm.DB, identity *Identity) (*user.User, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	resolved, err := resolveUser(tx, identity)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit linked identity", err)
+	}
+	return resolved, nil
+}
+
+func resolveUser(tx *gorm.DB, identity *Identity) (*user.User, error) {
+	now := time.Now()
+	email := mail.NormalizeAddress(identity.Email)
+
+	// 1. 이미 연결된 외부 계정
+	var linked user.UserIdentity
+	result := tx.Where("provider = ? AND subject = ?", identity.Provider, identity.Subject).First(&linked)
+	if result.Error == nil {
+		var existing user.User
+		if err := tx.Where("id = ?", linked.UserID).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, errors.NewForbiddenError(errors.ErrorCodeAccountPendingDeletion, "This account is pending deletion")
+			}
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query linked user", err)
+		}
+
+		if err := tx.Model(&linked).Updates(map[string]interface{}{
+			"email":          email,
+			"email_verified": identity.EmailVerified,
+		}).Error; err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to update linked identity", err)
+		}
+		return &existing, nil
+	} else if result.Error != gorm.ErrRecordNotFound {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query linked identity", result.Error)
+	}
+
+	if email == "" {
+		return nil, errors.NewBadRequestError(errors.ErrorCodeExternalAuthFailed, "Login provider did not share an email address")
+	}
+
+	// 2. 같은 이메일의 기존 계정 (제공자와 우리 서비스 모두 이메일을 인증한 경우에만 자동 연결)
+	var target user.User
+	result = tx.Unscoped().Where("LOWER(email) = ?", email).First(&target)
+	if result.Error == nil {
+		if target.DeletedAt.Valid {
+			return nil, errors.NewForbiddenError(errors.ErrorCodeAccountPendingDeletion, "This account is pending deletion")
+		}
+		// 이메일 인증을 마치지 않은 계정은 다른 사람이 먼저 가입한 계정일 수 있으므로 연결하지 않음
+		if !identity.EmailVerified || !target.IsEmailVerified() {
+			return nil, errors.NewConflictError(
+				errors.ErrorCodeResourceExists,
+				"An account with this email already exists; sign in with your password first",
+			)
+		}
+	} else if result.Error == gorm.ErrRecordNotFound {
+		// 3. 새 계정 생성 (비밀번호 없음)
+		target = user.User{Email: email}
+		if identity.EmailVerified {
+			target.EmailVerifiedAt = &now
+		}
+		if err := tx.Create(&target).Error; err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to create user", err)
+		}
+	} else {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query user", result.Error)
+	}
+
+	link := &user.UserIdentity{
+		UserID:        target.ID,
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         email,
+		EmailVerified: identity.EmailVerified,
+	}
+	if err := tx.Create(link).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to link identity", err)
+	}
+
+	return &target, nil
+}
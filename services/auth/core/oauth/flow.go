@@ -0,0 +1,304 @@
+package oauth
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/token"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// loginStateTTL은 인가 요청 후 콜백까지 허용하는 시간입니다
+const loginStateTTL = 10 * time.Minute
+
+// Identity는 외부 제공자가 확인해 준 사용자 정보입니다
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// BeginLogin은 state, PKCE verifier, nonce를 저장하고 제공자의 인가 URL을 반환합니다
+func (r *Registry) BeginLogin(ctx context.Context, db *gorm.DB, providerName string) (string, error) {
+	provider, err := r.provider(ctx, providerName)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := token.GenerateOpaqueToken(32)
+	if err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate state", err)
+	}
+	verifier, err := token.GenerateOpaqueToken(32)
+	if err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate PKCE verifier", err)
+	}
+	nonce, err := token.GenerateOpaqueToken(16)
+	if err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate nonce", err)
+	}
+
+	loginState := &user.OAuthLoginState{
+		StateHash:    token.HashToken(state),
+		Provider:     provider.Name,
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(loginStateTTL),
+	}
+	if err := db.Create(loginState).Error; err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store login state", err)
+	}
+
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientID)
+	query.Set("redirect_uri", provider.RedirectURL)
+	query.Set("scope", strings.Join(provider.Scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	if provider.Kind == KindOIDC {
+		query.Set("nonce", nonce)
+	}
+
+	separator := "?"
+	if strings.Contains(provider.AuthURL, "?") {
+		separator = "&"
+	}
+	return provider.AuthURL + separator + query.Encode(), nil
+}
+
+// CompleteLogin은 state를 검증하고 인가 코드를 토큰으로 교환한 뒤 사용자 정보를 반환합니다
+func (r *Registry) CompleteLogin(ctx context.Context, db *gorm.DB, providerName, code, state string) (*Identity, error) {
+	provider, err := r.provider(ctx, providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	// state는 한 번만 사용할 수 있도록 조회와 동시에 삭제
+	var loginState user.OAuthLoginState
+	result := db.Clauses(clause.Returning{}).
+		Where("state_hash = ? AND provider = ?", token.HashToken(state), provider.Name).
+		Delete(&loginState)
+	if result.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to consume login state", result.Error)
+	}
+	if result.RowsAffected == 0 || time.Now().After(loginState.ExpiresAt) {
+		return nil, errors.NewBadRequestError(errors.ErrorCodeInvalidToken, "Invalid or expired login state")
+	}
+
+	tokens, err := r.exchangeCode(ctx, provider, code, loginState.CodeVerifier)
+	if err != nil {
+		return nil, externalAuthError(err)
+	}
+
+	var identity *Identity
+	switch provider.Kind {
+	case KindGitHub:
+		identity, err = r.fetchGitHubIdentity(ctx, provider, tokens.AccessToken)
+	default:
+		identity, err = r.verifyIDToken(ctx, provider, tokens, loginState.Nonce)
+	}
+	if err != nil {
+		return nil, externalAuthError(err)
+	}
+
+	return identity, nil
+}
+
+func (r *Registry) provider(ctx context.Context, name string) (*Provider, error) {
+	provider, exists := r.Get(name)
+	if !exists {
+		return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Login provider not found")
+	}
+
+	if err := provider.discover(ctx, r.httpClient); err != nil {
+		return nil, externalAuthError(err)
+	}
+	return provider, nil
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (r *Registry) exchangeCode(ctx context.Context, provider *Provider, code, verifier string) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", provider.RedirectURL)
+	form.Set("client_id", provider.ClientID)
+	form.Set("code_verifier", verifier)
+	if provider.ClientSecret != "" {
+		form.Set("client_secret", provider.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, provider.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, fmt.Errorf("failed to decode token response (status %d): %v", resp.StatusCode, err)
+	}
+	if tokens.Error != "" {
+		return nil, fmt.Errorf("token endpoint error: %s %s", tokens.Error, tokens.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK || tokens.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+
+	return &tokens, nil
+}
+
+// flexibleBool은 true와 "true"를 모두 허용합니다 (일부 제공자는 email_verified를 문자열로 보냄)
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseBool(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*b = flexibleBool(value)
+	return nil
+}
+
+type idTokenClaims struct {
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Nonce         string       `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+func (r *Registry) verifyIDToken(ctx context.Context, provider *Provider, tokens *tokenResponse, nonce string) (*Identity, error) {
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("token response did not include an id_token")
+	}
+
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(tokens.IDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return provider.jwks.Key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(provider.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id_token: %v", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("id_token nonce mismatch")
+	}
+
+	identity := &Identity{
+		Provider:      provider.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+	}
+
+	// ID 토큰에 이메일이 없으면 userinfo 엔드포인트에서 보충
+	if identity.Email == "" && provider.UserInfoURL != "" {
+		var info struct {
+			Subject       string       `json:"sub"`
+			Email         string       `json:"email"`
+			EmailVerified flexibleBool `json:"email_verified"`
+		}
+		if err := r.getJSON(ctx, provider.UserInfoURL, tokens.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.Subject == identity.Subject {
+			identity.Email = info.Email
+			identity.EmailVerified = bool(info.EmailVerified)
+		}
+	}
+
+	return identity, nil
+}
+
+func (r *Registry) fetchGitHubIdentity(ctx context.Context, provider *Provider, accessToken string) (*Identity, error) {
+	var profile struct {
+		ID int64 `json:"id"`
+	}
+	if err := r.getJSON(ctx, provider.UserInfoURL+"/user", accessToken, &profile); err != nil {
+		return nil, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := r.getJSON(ctx, provider.UserInfoURL+"/user/emails", accessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Provider: provider.Name,
+		Subject:  strconv.FormatInt(profile.ID, 10),
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+
+	return identity, nil
+}
+
+func (r *Registry) getJSON(ctx context.Context, url, accessToken string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(target)
+}
+
+// externalAuthError는 제공자와의 통신 실패를 로깅하고 클라이언트에는 일반화된 에러를 반환합니다
+func externalAuthError(err error) error {
+	log.Printf("External login failed: %v", err)
+	return errors.NewAuthorizationError(errors.ErrorCodeExternalAuthFailed, "External login failed")
+}
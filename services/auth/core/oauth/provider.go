@@ -0,0 +1,197 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 제공자 종류
+const (
+	// KindOIDC는 discovery 문서와 ID 토큰을 사용하는 OpenID Connect 제공자입니다
+	KindOIDC = "oidc"
+	// KindGitHub는 ID 토큰 없이 REST API로 사용자 정보를 조회하는 GitHub OAuth2입니다
+	KindGitHub = "github"
+)
+
+// Provider는 외부 로그인 제공자 설정입니다
+type Provider struct {
+	Name         string
+	Kind         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// GitHub처럼 discovery가 없는 제공자는 직접 지정합니다
+	AuthURL     string
+	TokenURL    string
+	UserInfoURL string
+
+	mu         sync.Mutex
+	discovered bool
+	jwks       *jwksCache
+}
+
+// Registry는 이름으로 로그인 제공자를 찾습니다
+type Registry struct {
+	providers  map[string]*Provider
+	httpClient *http.Client
+}
+
+// NewRegistry는 주어진 제공자들로 레지스트리를 생성합니다
+func NewRegistry(providers ...*Provider) *Registry {
+	registry := &Registry{
+		providers:  map[string]*Provider{},
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	for _, provider := range providers {
+		registry.providers[provider.Name] = provider
+	}
+	return registry
+}
+
+// Get은 이름으로 제공자를 찾습니다
+func (r *Registry) Get(name string) (*Provider, bool) {
+	provider, exists := r.providers[name]
+	return provider, exists
+}
+
+// Names는 등록된 제공자 이름 목록을 반환합니다
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewRegistryFromEnv는 환경 변수에 클라이언트 ID가 설정된 제공자만 등록합니다.
+//
+//	OAUTH_GOOGLE_CLIENT_ID / OAUTH_GOOGLE_CLIENT_SECRET
+//	OAUTH_GITHUB_CLIENT_ID / OAUTH_GITHUB_CLIENT_SECRET
+//	OAUTH_KAKAO_CLIENT_ID  / OAUTH_KAKAO_CLIENT_SECRET
+//	OIDC_ISSUER / OIDC_CLIENT_ID / OIDC_CLIENT_SECRET / OIDC_PROVIDER_NAME (범용 OIDC)
+func NewRegistryFromEnv() *Registry {
+	baseURL := os.Getenv("OAUTH_REDIRECT_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:3000"
+	}
+	redirectURL := func(name string) string {
+		return fmt.Sprintf("%s/api/v1/auth/oauth/%s/callback", strings.TrimRight(baseURL, "/"), name)
+	}
+
+	var providers []*Provider
+	if clientID := os.Getenv("OAUTH_GOOGLE_CLIENT_ID"); clientID != "" {
+		providers = append(providers, &Provider{
+			Name:         "google",
+			Kind:         KindOIDC,
+			Issuer:       "https://accounts.google.com",
+			ClientID:     clientID,
+			ClientSecret: os.Getenv("OAUTH_GOOGLE_CLIENT_SECRET"),
+			RedirectURL:  redirectURL("google"),
+			Scopes:       []string{"openid", "email", "profile"},
+		})
+	}
+	if clientID := os.Getenv("OAUTH_KAKAO_CLIENT_ID"); clientID != "" {
+		providers = append(providers, &Provider{
+			Name:         "kakao",
+			Kind:         KindOIDC,
+			Issuer:       "https://kauth.kakao.com",
+			ClientID:     clientID,
+			ClientSecret: os.Getenv("OAUTH_KAKAO_CLIENT_SECRET"),
+			RedirectURL:  redirectURL("kakao"),
+			Scopes:       []string{"openid", "account_email"},
+		})
+	}
+	if clientID := os.Getenv("OAUTH_GITHUB_CLIENT_ID"); clientID != "" {
+		providers = append(providers, &Provider{
+			Name:         "github",
+			Kind:         KindGitHub,
+			ClientID:     clientID,
+			ClientSecret: os.Getenv("OAUTH_GITHUB_CLIENT_SECRET"),
+			RedirectURL:  redirectURL("github"),
+			Scopes:       []string{"read:user", "user:email"},
+			AuthURL:      "https://github.com/login/oauth/authorize",
+			TokenURL:     "https://github.com/login/oauth/access_token",
+			UserInfoURL:  "https://api.github.com",
+		})
+	}
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		name := os.Getenv("OIDC_PROVIDER_NAME")
+		if name == "" {
+			name = "oidc"
+		}
+		providers = append(providers, &Provider{
+			Name:         name,
+			Kind:         KindOIDC,
+			Issuer:       strings.TrimRight(issuer, "/"),
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  redirectURL(name),
+			Scopes:       []string{"openid", "email", "profile"},
+		})
+	}
+
+	return NewRegistry(providers...)
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// discover는 OIDC discovery 문서를 조회해 엔드포인트를 채웁니다. 성공하면 이후에는 다시 조회하지 않습니다
+func (p *Provider) discover(ctx context.Context, client *http.Client) error {
+	if p.Kind != KindOIDC {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovered {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch discovery document: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("discovery document returned status %d", resp.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("failed to decode discovery document: %v", err)
+	}
+	if strings.TrimRight(doc.Issuer, "/") != p.Issuer {
+		return fmt.Errorf("discovery issuer mismatch: %s", doc.Issuer)
+	}
+
+	p.Issuer = doc.Issuer
+	p.AuthURL = doc.AuthorizationEndpoint
+	p.TokenURL = doc.TokenEndpoint
+	p.UserInfoURL = doc.UserInfoEndpoint
+	p.jwks = newJWKSCache(doc.JWKSURI, client)
+	p.discovered = true
+	return nil
+}
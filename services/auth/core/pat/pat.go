@@ -0,0 +1,114 @@
+package pat
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/token"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// TokenPrefix는 개인 액세스 토큰을 JWT와 구분하기 위한 접두사입니다
+	TokenPrefix = "clp_"
+	// tokenBytes는 토큰 원문의 랜덤 바이트 길이입니다
+	tokenBytes = 32
+	// lastUsedResolution은 last_used_at 갱신 최소 간격입니다 (매 요청마다 쓰지 않기 위함)
+	lastUsedResolution = time.Minute
+)
+
+// IsPersonalAccessToken은 Bearer 토큰이 개인 액세스 토큰 형식인지 확인합니다
+func IsPersonalAccessToken(raw string) bool {
+	return strings.HasPrefix(raw, TokenPrefix)
+}
+
+// Create는 새 개인 액세스 토큰을 발급하고 원문을 반환합니다
+func Create(db *gorm.DB, userID, name string, scopes []string, expiresAt *time.Time) (*user.PersonalAccessToken, string, error) {
+	random, err := token.GenerateOpaqueToken(tokenBytes)
+	if err != nil {
+		return nil, "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate token", err)
+	}
+	raw := TokenPrefix + random
+
+	accessToken := &user.PersonalAccessToken{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: token.HashToken(raw),
+		TokenHint: raw[:len(TokenPrefix)+6],
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: expiresAt,
+	}
+	if err := db.Create(accessToken).Error; err != nil {
+		return nil, "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to create personal access token", err)
+	}
+
+	return accessToken, raw, nil
+}
+
+// Authenticate는 토큰 원문을 검증하고 토큰과 소유자를 반환합니다
+func Authenticate(db *gorm.DB, raw string) (*user.PersonalAccessToken, *user.User, error) {
+	var accessToken user.PersonalAccessToken
+	result := db.Where("token_hash = ?", token.HashToken(raw)).First(&accessToken)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil, errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid or expired token")
+		}
+		return nil, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query personal access token", result.Error)
+	}
+
+	now := time.Now()
+	if !accessToken.IsActive(now) {
+		return nil, nil, errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid or expired token")
+	}
+
+	var owner user.User
+	if err := db.Where("id = ?", accessToken.UserID).First(&owner).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid or expired token")
+		}
+		return nil, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query token owner", err)
+	}
+
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) > lastUsedResolution {
+		if err := db.Model(&accessToken).UpdateColumn("last_used_at", now).Error; err != nil {
+			return nil, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to update token usage", err)
+		}
+	}
+
+	return &accessToken, &owner, nil
+}
+
+// List는 사용자의 개인 액세스 토큰 목록을 최신순으로 반환합니다
+func List(db *gorm.DB, userID string) ([]user.PersonalAccessToken, error) {
+	var tokens []user.PersonalAccessToken
+	if err := db.Where("user_id = ?", userID).Order("created_at desc").Find(&tokens).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to list personal access tokens", err)
+	}
+	return tokens, nil
+}
+
+// Revoke는 사용자의 개인 액세스 토큰을 폐기합니다
+func Revoke(db *gorm.DB, userID, tokenID string) error {
+	result := db.Model(&user.PersonalAccessToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", tokenID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke personal access token", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Personal access token not found")
+	}
+	return nil
+}
+
+// RevokeAll은 사용자의 모든 개인 액세스 토큰을 폐기합니다
+func RevokeAll(db *gorm.DB, userID string) error {
+	if err := db.Model(&user.PersonalAccessToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke personal access tokens", err)
+	}
+	return nil
+}
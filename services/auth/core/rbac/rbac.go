@@ -0,0 +1,60 @@
+package rbac
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/utils/mail"
+	"log"
+	"os"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// GrantRole은 사용자에게 역할을 추가합니다. 이미 가진 역할이면 아무것도 하지 않습니다.
+// 이미 발급된 액세스 토큰에는 다음 토큰 갱신 시점부터 반영됩니다.
+func GrantRole(db *gorm.DB, target *user.User, role enums.Role) error {
+	if !role.IsValid() {
+		return errors.NewValidationError(errors.ErrorCodeInvalidInput, "Unknown role", role.String())
+	}
+	if target.HasRole(role) {
+		return nil
+	}
+
+	roles := append(target.RoleList(), role.String())
+	if err := db.Model(target).UpdateColumn("roles", strings.Join(roles, ",")).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to grant role", err)
+	}
+	target.Roles = strings.Join(roles, ",")
+	return nil
+}
+
+// BootstrapAdminsFromEnv는 ADMIN_EMAILS(쉼표 구분)에 등록된 기존 계정에 관리자 역할을 부여합니다.
+// 다른 사람이 먼저 가입했을 수 있으므로 이메일 인증을 마치지 않은 계정은 건너뜁니다.
+func BootstrapAdminsFromEnv(db *gorm.DB) error {
+	for _, email := range strings.Split(os.Getenv("ADMIN_EMAILS"), ",") {
+		email = mail.NormalizeAddress(email)
+		if email == "" {
+			continue
+		}
+
+		var target user.User
+		if err := db.Where("LOWER(email) = ?", email).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				log.Printf("ADMIN_EMAILS: user %s not found, skipping", email)
+				continue
+			}
+			return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query database", err)
+		}
+		if !target.IsEmailVerified() {
+			log.Printf("ADMIN_EMAILS: user %s has not verified the email address, skipping", email)
+			continue
+		}
+
+		if err := GrantRole(db, &target, enums.AdminRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package session
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/token"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// refreshTokenBytes는 리프레시 토큰 원문의 랜덤 바이트 길이입니다
+	refreshTokenBytes = 32
+	// lastSeenResolution은 last_seen_at 갱신 최소 간격입니다 (매 요청마다 쓰지 않기 위함)
+	lastSeenResolution = time.Minute
+)
+
+// IssuedSession은 새로 발급된 세션과 리프레시 토큰 원문을 담습니다
+type IssuedSession struct {
+	Session      *user.UserSession
+	RefreshToken string
+}
+
+// RefreshTokenTTL은 리프레시 토큰(및 세션)의 유효 기간을 반환합니다
+func RefreshTokenTTL() time.Duration {
+	hours, _ := strconv.Atoi(os.Getenv("JWT_REFRESH_EXPIRY_HOURS"))
+	if hours == 0 {
+		hours = 24 * 14 // 기본값 14일
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// CreateSession은 로그인 시 새 세션을 만들고 첫 리프레시 토큰을 발급합니다
+// amr에는 로그인 시 거친 인증 방식을 담으며, 토큰 갱신 시에도 그대로 유지됩니다.
+func CreateSession(db *gorm.DB, userID, userAgent, ipAddress string, amr []string) (*IssuedSession, error) {
+	now := time.Now()
+	session := &user.UserSession{
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		AMR:        strings.Join(amr, ","),
+		ExpiresAt:  now.Add(RefreshTokenTTL()),
+		LastSeenAt: now,
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	if err := tx.Create(session).Error; err != nil {
+		tx.Rollback()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to create session", err)
+	}
+
+	refreshToken, err := issueRefreshToken(tx, session)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit session", err)
+	}
+
+	return &IssuedSession{Session: session, RefreshToken: refreshToken}, nil
+}
+
+// RotateRefreshToken은 리프레시 토큰을 사용 처리하고 같은 세션에 새 토큰을 발급합니다.
+// 이미 사용된 토큰이 다시 제시되면 탈취로 간주하여 세션 전체를 폐기합니다.
+func RotateRefreshToken(db *gorm.DB, refreshToken string) (*IssuedSession, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	var stored user.UserRefreshToken
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", token.HashToken(refreshToken)).
+		First(&stored)
+	if result.Error != nil {
+		tx.Rollback()
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid refresh token")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query refresh token", result.Error)
+	}
+
+	var session user.UserSession
+	if err := tx.Where("id = ?", stored.SessionID).First(&session).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid refresh token")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query session", err)
+	}
+
+	now := time.Now()
+
+	// 재사용 감지: 이미 사용된 토큰이면 세션(토큰 패밀리) 전체를 폐기합니다
+	if stored.UsedAt != nil {
+		if err := revokeSession(tx, &session, now); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit session revocation", err)
+		}
+		return nil, errors.NewAuthorizationError(errors.ErrorCodeTokenRevoked, "Refresh token reuse detected; session has been revoked")
+	}
+
+	if !session.IsActive(now) {
+		tx.Rollback()
+		return nil, errors.NewAuthorizationError(errors.ErrorCodeTokenRevoked, "Session has been revoked or expired")
+	}
+
+	if now.After(stored.ExpiresAt) {
+		tx.Rollback()
+		return nil, errors.NewAuthorizationError(errors.ErrorCodeTokenExpired, "Refresh token has expired")
+	}
+
+	if err := tx.Model(&stored).Update("used_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to update refresh token", err)
+	}
+
+	newToken, err := issueRefreshToken(tx, &session)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit refresh token rotation", err)
+	}
+
+	return &IssuedSession{Session: &session, RefreshToken: newToken}, nil
+}
+
+// RevokeByRefreshToken은 리프레시 토큰이 속한 세션을 폐기합니다 (로그아웃)
+func RevokeByRefreshToken(db *gorm.DB, refreshToken string) error {
+	var stored user.UserRefreshToken
+	result := db.Where("token_hash = ?", token.HashToken(refreshToken)).First(&stored)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return errors.NewAuthorizationError(errors.ErrorCodeInvalidToken, "Invalid refresh token")
+		}
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query refresh token", result.Error)
+	}
+
+	return RevokeSession(db, stored.SessionID)
+}
+
+// RevokeSession은 세션을 폐기합니다. 이미 폐기된 세션이면 아무 것도 하지 않습니다
+func RevokeSession(db *gorm.DB, sessionID string) error {
+	var session user.UserSession
+	result := db.Where("id = ?", sessionID).First(&session)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Session not found")
+		}
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query session", result.Error)
+	}
+
+	return revokeSession(db, &session, time.Now())
+}
+
+// ListActiveSessions는 사용자의 활성 세션 목록을 최근 사용 순으로 반환합니다
+func ListActiveSessions(db *gorm.DB, userID string) ([]user.UserSession, error) {
+	var sessions []user.UserSession
+	if err := db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at desc").
+		Find(&sessions).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to list sessions", err)
+	}
+	return sessions, nil
+}
+
+// RevokeUserSession은 사용자 본인의 세션을 폐기합니다. 다른 사용자의 세션이면 찾을 수 없음으로 처리합니다
+func RevokeUserSession(db *gorm.DB, userID, sessionID string) error {
+	var session user.UserSession
+	result := db.Where("id = ? AND user_id = ?", sessionID, userID).First(&session)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Session not found")
+		}
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query session", result.Error)
+	}
+
+	return revokeSession(db, &session, time.Now())
+}
+
+// RevokeAllSessions는 사용자의 모든 활성 세션을 폐기합니다. exceptSessionID가 주어지면 해당 세션은 유지합니다
+func RevokeAllSessions(db *gorm.DB, userID, exceptSessionID string) error {
+	query := db.Model(&user.UserSession{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptSessionID != "" {
+		query = query.Where("id <> ?", exceptSessionID)
+	}
+
+	if err := query.Update("revoked_at", time.Now()).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke sessions", err)
+	}
+	return nil
+}
+
+func revokeSession(db *gorm.DB, session *user.UserSession, now time.Time) error {
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	if err := db.Model(session).Update("revoked_at", now).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke session", err)
+	}
+	return nil
+}
+
+func issueRefreshToken(db *gorm.DB, session *user.UserSession) (string, error) {
+	raw, err := token.GenerateOpaqueToken(refreshTokenBytes)
+	if err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate refresh token", err)
+	}
+
+	refreshToken := &user.UserRefreshToken{
+		SessionID: session.ID,
+		TokenHash: token.HashToken(raw),
+		ExpiresAt: session.ExpiresAt,
+	}
+	if err := db.Create(refreshToken).Error; err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store refresh token", err)
+	}
+
+	return raw, nil
+}
+
+// Validator는 액세스 토큰의 세션이 아직 유효한지 DB에서 확인합니다
+type Validator struct {
+	db *gorm.DB
+}
+
+// NewValidator는 새로운 세션 Validator를 생성합니다
+func NewValidator(db *gorm.DB) *Validator {
+	return &Validator{db: db}
+}
+
+// IsSessionActive는 jwt.SessionValidator 인터페이스를 구현합니다.
+// 활성 세션이면 last_seen_at을 최소 간격(lastSeenResolution)마다 한 번씩만 갱신합니다.
+func (v *Validator) IsSessionActive(sessionID string) (bool, error) {
+	var session user.UserSession
+	result := v.db.Select("id", "revoked_at", "expires_at", "last_seen_at").Where("id = ?", sessionID).First(&session)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, result.Error
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) {
+		return false, nil
+	}
+
+	if now.Sub(session.LastSeenAt) > lastSeenResolution {
+		// 동시 요청이 같은 값을 반복해서 쓰지 않도록 조건부로 갱신합니다
+		if err := v.db.Model(&user.UserSession{}).
+			Where("id = ? AND last_seen_at < ?", sessionID, now.Add(-lastSeenResolution)).
+			UpdateColumn("last_seen_at", now).Error; err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,190 @@
+package throttle
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/env"
+	"career-log-be/utils/mail"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 로그인 실패 사유
+const (
+	ReasonUnknownEmail    = "UNKNOWN_EMAIL"
+	ReasonInvalidPassword = "INVALID_PASSWORD"
+	ReasonInvalidMFACode  = "INVALID_MFA_CODE"
+	ReasonThrottled       = "THROTTLED"
+)
+
+// Config는 로그인 시도 제한 설정입니다
+type Config struct {
+	MaxAccountFailures int           // 계정 잠금까지 허용하는 실패 횟수
+	MaxIPFailures      int           // IP 차단까지 허용하는 실패 횟수
+	LockoutDuration    time.Duration // 잠금 유지 시간
+	FailureWindow      time.Duration // 마지막 실패 후 이 시간이 지나면 카운터 초기화
+	BaseBackoff        time.Duration // 지수 백오프의 기본 대기 시간
+	MaxBackoff         time.Duration // 지수 백오프의 최대 대기 시간
+}
+
+// ConfigFromEnv는 환경 변수에서 설정을 읽습니다
+func ConfigFromEnv() Config {
+	return Config{
+		MaxAccountFailures: env.Int("LOGIN_MAX_ACCOUNT_FAILURES", 5),
+		MaxIPFailures:      env.Int("LOGIN_MAX_IP_FAILURES", 20),
+		LockoutDuration:    time.Duration(env.Int("LOGIN_LOCKOUT_MINUTES", 15)) * time.Minute,
+		FailureWindow:      time.Duration(env.Int("LOGIN_FAILURE_WINDOW_MINUTES", 60)) * time.Minute,
+		BaseBackoff:        time.Second,
+		MaxBackoff:         time.Minute,
+	}
+}
+
+// Attempt는 하나의 로그인 시도 정보입니다
+type Attempt struct {
+	Email     string
+	UserID    *string
+	IPAddress string
+	UserAgent string
+}
+
+// AccountKey는 계정 단위 카운터 키를 반환합니다
+func AccountKey(email string) string {
+	return "email:" + mail.NormalizeAddress(email)
+}
+
+// IPKey는 IP 단위 카운터 키를 반환합니다
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Backoff는 실패 횟수에 따른 다음 시도까지의 대기 시간을 반환합니다
+func (c Config) Backoff(failureCount int) time.Duration {
+	if failureCount <= 0 {
+		return 0
+	}
+	delay := time.Duration(float64(c.BaseBackoff) * math.Pow(2, float64(failureCount-1)))
+	if delay > c.MaxBackoff || delay <= 0 {
+		return c.MaxBackoff
+	}
+	return delay
+}
+
+// Check는 로그인 시도가 허용되는지 확인합니다.
+// 차단된 경우 재시도까지 남은 시간과 에러를 반환합니다.
+func Check(db *gorm.DB, config Config, attempt Attempt) (time.Duration, error) {
+	accountKey := AccountKey(attempt.Email)
+	ipKey := IPKey(attempt.IPAddress)
+
+	var throttles []user.LoginThrottle
+	if err := db.Where("key IN ?", []string{accountKey, ipKey}).Find(&throttles).Error; err != nil {
+		return 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query login throttle", err)
+	}
+
+	now := time.Now()
+	for _, throttle := range throttles {
+		if throttle.LockedUntil != nil && now.Before(*throttle.LockedUntil) {
+			retryAfter := throttle.LockedUntil.Sub(now)
+			details := retryDetails(retryAfter)
+			if throttle.Key == accountKey {
+				return retryAfter, errors.NewLockedError(
+					errors.ErrorCodeAccountLocked,
+					"Account is temporarily locked due to too many failed login attempts",
+					details,
+				)
+			}
+			return retryAfter, errors.NewRateLimitedError(
+				errors.ErrorCodeAccountLocked,
+				"Too many failed login attempts from this IP address",
+				details,
+			)
+		}
+
+		// 잠금이 끝났고 실패 윈도우가 지난 카운터는 무시 (잠금 기간이 윈도우보다 길어도 잠금은 유지)
+		if now.Sub(throttle.LastFailureAt) > config.FailureWindow {
+			continue
+		}
+
+		nextAllowedAt := throttle.LastFailureAt.Add(config.Backoff(throttle.FailureCount))
+		if now.Before(nextAllowedAt) {
+			retryAfter := nextAllowedAt.Sub(now)
+			return retryAfter, errors.NewRateLimitedError(
+				errors.ErrorCodeAccountLocked,
+				"Too many failed login attempts, please retry later",
+				retryDetails(retryAfter),
+			)
+		}
+	}
+
+	return 0, nil
+}
+
+// RecordFailure는 계정/IP 실패 카운터를 원자적으로 증가시키고 감사 로그를 남깁니다
+func RecordFailure(db *gorm.DB, config Config, attempt Attempt, reason string) error {
+	now := time.Now()
+	windowStart := now.Add(-config.FailureWindow)
+	lockedUntil := now.Add(config.LockoutDuration)
+
+	counters := []struct {
+		key       string
+		threshold int
+	}{
+		{AccountKey(attempt.Email), config.MaxAccountFailures},
+		{IPKey(attempt.IPAddress), config.MaxIPFailures},
+	}
+
+	for _, counter := range counters {
+		err := db.Exec(`
+			INSERT INTO login_throttles (key, failure_count, last_failure_at, locked_until)
+			VALUES (@key, 1, @now, CASE WHEN 1 >= @threshold THEN CAST(@lockedUntil AS timestamptz) ELSE NULL END)
+			ON CONFLICT (key) DO UPDATE SET
+				failure_count = CASE WHEN login_throttles.last_failure_at < @windowStart THEN 1 ELSE login_throttles.failure_count + 1 END,
+				last_failure_at = @now,
+				locked_until = CASE
+					WHEN (CASE WHEN login_throttles.last_failure_at < @windowStart THEN 1 ELSE login_throttles.failure_count + 1 END) >= @threshold
+					THEN CAST(@lockedUntil AS timestamptz)
+					ELSE login_throttles.locked_until
+				END`,
+			map[string]interface{}{
+				"key":         counter.key,
+				"now":         now,
+				"threshold":   counter.threshold,
+				"lockedUntil": lockedUntil,
+				"windowStart": windowStart,
+			},
+		).Error
+		if err != nil {
+			return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to record login failure", err)
+		}
+	}
+
+	return RecordAudit(db, attempt, reason)
+}
+
+// RecordAudit는 카운터를 변경하지 않고 실패 감사 로그만 남깁니다
+func RecordAudit(db *gorm.DB, attempt Attempt, reason string) error {
+	audit := &user.FailedLoginAudit{
+		Email:     mail.NormalizeAddress(attempt.Email),
+		UserID:    attempt.UserID,
+		IPAddress: attempt.IPAddress,
+		UserAgent: attempt.UserAgent,
+		Reason:    reason,
+	}
+	if err := db.Create(audit).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to record login audit", err)
+	}
+	return nil
+}
+
+// RecordSuccess는 로그인 성공 시 계정 카운터를 초기화합니다. IP 카운터는 유지합니다
+func RecordSuccess(db *gorm.DB, email string) error {
+	if err := db.Where("key = ?", AccountKey(email)).Delete(&user.LoginThrottle{}).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to reset login throttle", err)
+	}
+	return nil
+}
+
+func retryDetails(retryAfter time.Duration) map[string]int {
+	return map[string]int{"retryAfterSeconds": int(math.Ceil(retryAfter.Seconds()))}
+}
@@ -0,0 +1,77 @@
+package verification
+
+import (
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/utils/mail"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// appBaseURL은 메일 본문 링크에 사용할 프론트엔드 주소를 반환합니다
+func appBaseURL() string {
+	if baseURL := os.Getenv("APP_BASE_URL"); baseURL != "" {
+		return baseURL
+	}
+	return "http://localhost:3000"
+}
+
+// SendVerificationEmail은 이메일 인증 토큰을 발급하고 인증 메일을 발송합니다
+func SendVerificationEmail(ctx context.Context, db *gorm.DB, sender mail.Sender, target *user.User) error {
+	raw, err := IssueToken(db, target.ID, enums.EmailVerificationPurpose, EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", appBaseURL(), url.QueryEscape(raw))
+	return sender.Send(ctx, mail.Message{
+		To:      target.Email,
+		Subject: "[Career Log] 이메일 주소를 인증해주세요",
+		Body: fmt.Sprintf(
+			"아래 링크를 눌러 이메일 인증을 완료해주세요.\n\n%s\n\n이 링크는 %d시간 동안 유효합니다.",
+			link, int(EmailVerificationTTL.Hours()),
+		),
+	})
+}
+
+// SendPasswordResetEmail은 비밀번호 재설정 토큰을 발급하고 재설정 메일을 발송합니다
+func SendPasswordResetEmail(ctx context.Context, db *gorm.DB, sender mail.Sender, target *user.User) error {
+	raw, err := IssueToken(db, target.ID, enums.PasswordResetPurpose, PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", appBaseURL(), url.QueryEscape(raw))
+	return sender.Send(ctx, mail.Message{
+		To:      target.Email,
+		Subject: "[Career Log] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf(
+			"아래 링크를 눌러 비밀번호를 재설정해주세요.\n\n%s\n\n이 링크는 %d분 동안 유효합니다. 본인이 요청하지 않았다면 이 메일을 무시하세요.",
+			link, int(PasswordResetTTL.Minutes()),
+		),
+	})
+}
+
+// SendAccountRestoreEmail은 계정 복구 토큰을 발급하고 탈퇴 안내 및 복구 메일을 발송합니다.
+// 복구 토큰은 영구 삭제 예정 시각까지 유효합니다.
+func SendAccountRestoreEmail(ctx context.Context, db *gorm.DB, sender mail.Sender, target *user.User, purgeAfter time.Time) error {
+	raw, err := IssueToken(db, target.ID, enums.AccountRestorePurpose, time.Until(purgeAfter))
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/restore-account?token=%s", appBaseURL(), url.QueryEscape(raw))
+	return sender.Send(ctx, mail.Message{
+		To:      target.Email,
+		Subject: "[Career Log] 계정 탈퇴가 접수되었습니다",
+		Body: fmt.Sprintf(
+			"계정 탈퇴 요청이 접수되었습니다. %s 이후 모든 데이터가 영구 삭제됩니다.\n\n마음이 바뀌셨다면 그 전에 아래 링크를 눌러 계정을 복구할 수 있습니다.\n\n%s",
+			purgeAfter.Format("2006-01-02 15:04 MST"), link,
+		),
+	})
+}
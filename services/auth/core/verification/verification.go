@@ -0,0 +1,107 @@
+package verification
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/utils/token"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// actionTokenBytes는 일회용 토큰 원문의 랜덤 바이트 길이입니다
+	actionTokenBytes = 32
+
+	PasswordResetTTL     = time.Hour
+	EmailVerificationTTL = 24 * time.Hour
+)
+
+// IssueToken은 일회용 토큰을 발급합니다. 같은 용도의 미사용 토큰은 모두 무효화됩니다
+func IssueToken(db *gorm.DB, userID string, purpose enums.TokenPurpose, ttl time.Duration) (string, error) {
+	raw, err := token.GenerateOpaqueToken(actionTokenBytes)
+	if err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to generate token", err)
+	}
+
+	now := time.Now()
+	tx := db.Begin()
+	if tx.Error != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	// 이전에 발급된 토큰 무효화
+	if err := tx.Model(&user.UserActionToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", now).Error; err != nil {
+		tx.Rollback()
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to invalidate previous tokens", err)
+	}
+
+	actionToken := &user.UserActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: token.HashToken(raw),
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := tx.Create(actionToken).Error; err != nil {
+		tx.Rollback()
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store token", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return "", errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit token", err)
+	}
+
+	return raw, nil
+}
+
+// ConsumeToken은 토큰을 검증하고 사용 처리합니다. 반드시 트랜잭션 안에서 호출해야 합니다
+func ConsumeToken(tx *gorm.DB, raw string, purpose enums.TokenPurpose) (*user.UserActionToken, error) {
+	var actionToken user.UserActionToken
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ? AND purpose = ?", token.HashToken(raw), purpose).
+		First(&actionToken)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errors.NewBadRequestError(errors.ErrorCodeInvalidToken, "Invalid token")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query token", result.Error)
+	}
+
+	now := time.Now()
+	if actionToken.UsedAt != nil {
+		return nil, errors.NewBadRequestError(errors.ErrorCodeInvalidToken, "Token has already been used")
+	}
+	if now.After(actionToken.ExpiresAt) {
+		return nil, errors.NewBadRequestError(errors.ErrorCodeTokenExpired, "Token has expired")
+	}
+
+	if err := tx.Model(&actionToken).Update("used_at", now).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to update token", err)
+	}
+
+	return &actionToken, nil
+}
+
+// GrandfatherExistingUsers는 이메일 인증이 도입되기 전에 가입한 사용자를 인증된 것으로 처리하고 처리한 수를 반환합니다.
+// email_verified_at 컬럼이 아직 없을 때(AutoMigrate 전)만 컬럼을 추가하고 가입 시각으로 채우므로 한 번만 실행됩니다.
+func GrandfatherExistingUsers(db *gorm.DB) (int64, error) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&user.User{}) || migrator.HasColumn(&user.User{}, "EmailVerifiedAt") {
+		return 0, nil
+	}
+
+	var grandfathered int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().AddColumn(&user.User{}, "EmailVerifiedAt"); err != nil {
+			return err
+		}
+		result := tx.Exec("UPDATE users SET email_verified_at = created_at WHERE email_verified_at IS NULL")
+		grandfathered = result.RowsAffected
+		return result.Error
+	})
+	return grandfathered, err
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type DisableTOTPInput struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// HandleDisableTOTP는 현재 TOTP 코드를 다시 확인한 뒤 2단계 인증을 해제합니다
+func HandleDisableTOTP() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		input := new(DisableTOTPInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		verified, err := mfa.Verify(db, userID, input.Code)
+		if err != nil {
+			return err
+		}
+		if !verified {
+			return appErrors.NewAuthorizationError(
+				appErrors.ErrorCodeInvalidMFACode,
+				"Invalid verification code",
+			)
+		}
+
+		if err := mfa.Disable(db, userID); err != nil {
+			return err
+		}
+
+		return response.NoContent(c)
+	}
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type EnrollTOTPResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// HandleEnrollTOTP는 TOTP 비밀키를 발급합니다. 클라이언트는 otpauth_uri를 QR 코드로 보여줍니다
+func HandleEnrollTOTP() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		var target user.User
+		if err := db.Where("id = ?", userID).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewNotFoundError(
+					appErrors.ErrorCodeResourceNotFound,
+					"User not found",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		secret, uri, err := mfa.Enroll(db, &target)
+		if err != nil {
+			return err
+		}
+
+		return response.Created(c, EnrollTOTPResponse{
+			Secret:     secret,
+			OTPAuthURI: uri,
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/utils/mail"
+	"career-log-be/utils/response"
+	"context"
+	"log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ForgotPasswordInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// HandleForgotPassword는 비밀번호 재설정 메일을 발송합니다.
+// 계정 존재 여부를 노출하지 않도록 항상 같은 응답을 반환하며, 메일은 백그라운드에서 발송합니다.
+func HandleForgotPassword() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		sender := c.Locals("mail").(mail.Sender)
+		input := new(ForgotPasswordInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+		input.Email = mail.NormalizeAddress(input.Email)
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		var target user.User
+		result := db.Where("LOWER(email) = ?", input.Email).First(&target)
+		if result.Error == nil {
+			// 응답 시간으로 계정 존재 여부를 알 수 없도록 메일은 응답과 관계없이 백그라운드에서 발송합니다
+			go func() {
+				if err := verification.SendPasswordResetEmail(context.Background(), db, sender, &target); err != nil {
+					log.Printf("Failed to send password reset email to user %s: %v", target.ID, err)
+				}
+			}()
+		} else if result.Error != gorm.ErrRecordNotFound {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				result.Error,
+			)
+		}
+
+		return response.Accepted(c, "If the email is registered, a password reset link has been sent")
+	}
+}
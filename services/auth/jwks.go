@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"career-log-be/utils/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleJWKS는 액세스 토큰 검증용 공개키 목록(JWK Set)을 반환합니다
+func HandleJWKS() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
+
+		c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+		return c.JSON(jwtUtils.JWKS())
+	}
+}
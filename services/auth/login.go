@@ -3,9 +3,16 @@ package auth
 import (
 	appErrors "career-log-be/errors"
 	"career-log-be/models/user"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/services/auth/core/session"
+	"career-log-be/services/auth/core/throttle"
 	"career-log-be/utils/jwt"
+	"career-log-be/utils/mail"
 	"career-log-be/utils/response"
 	"errors"
+	"log"
+	"math"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -19,14 +26,24 @@ type LoginInput struct {
 }
 
 type LoginResponse struct {
-	Token string `json:"token"`
-	User  struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	User         struct {
 		ID    string `json:"id"`
 		Email string `json:"email"`
 	} `json:"user"`
 }
 
+type MFAChallengeResponse struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
 func HandleLogin() fiber.Handler {
+	throttleConfig := throttle.ConfigFromEnv()
+
 	return func(c *fiber.Ctx) error {
 		db := c.Locals("db").(*gorm.DB)
 		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
@@ -38,6 +55,7 @@ func HandleLogin() fiber.Handler {
 				"Invalid request body",
 			)
 		}
+		input.Email = mail.NormalizeAddress(input.Email)
 
 		// Validate input
 		if err := validate.Struct(input); err != nil {
@@ -49,11 +67,22 @@ func HandleLogin() fiber.Handler {
 			)
 		}
 
+		// 로그인 시도 제한 확인
+		attempt := throttle.Attempt{
+			Email:     input.Email,
+			IPAddress: c.IP(),
+			UserAgent: c.Get(fiber.HeaderUserAgent),
+		}
+		if err := checkLoginThrottle(c, db, throttleConfig, attempt); err != nil {
+			return err
+		}
+
 		// 사용자 찾기
 		var user user.User
-		result := db.Where("email = ?", input.Email).First(&user)
+		result := db.Where("LOWER(email) = ?", input.Email).First(&user)
 		if result.Error != nil {
 			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				recordLoginFailure(db, throttleConfig, attempt, throttle.ReasonUnknownEmail)
 				return appErrors.NewAuthorizationError(
 					appErrors.ErrorCodeInvalidCredentials,
 					"Invalid email or password",
@@ -68,31 +97,107 @@ func HandleLogin() fiber.Handler {
 
 		// 비밀번호 확인
 		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
+			attempt.UserID = &user.ID
+			recordLoginFailure(db, throttleConfig, attempt, throttle.ReasonInvalidPassword)
 			return appErrors.NewAuthorizationError(
 				appErrors.ErrorCodeInvalidCredentials,
 				"Invalid email or password",
 			)
 		}
 
-		// JWT 토큰 생성
-		token, err := jwtUtils.GenerateToken(user.ID, user.Email)
-		if err != nil {
-			return appErrors.NewInternalError(
-				appErrors.ErrorCodeInternalError,
-				"Could not generate token",
-				err,
-			)
-		}
+		return respondWithLoginResult(c, db, jwtUtils, &user, []string{mfa.AMRPassword})
+	}
+}
 
-		// 응답 생성
-		resp := LoginResponse{
-			Token: token,
-			User: struct {
-				ID    string `json:"id"`
-				Email string `json:"email"`
-			}{ID: user.ID, Email: user.Email},
-		}
+// respondWithLoginResult는 첫 번째 인증을 마친 사용자에게 토큰을 발급합니다.
+// 2단계 인증이 활성화된 경우 토큰 대신 챌린지 토큰만 발급합니다.
+func respondWithLoginResult(c *fiber.Ctx, db *gorm.DB, jwtUtils *jwt.JWTUtils, target *user.User, amr []string) error {
+	mfaEnabled, err := mfa.IsEnabled(db, target.ID)
+	if err != nil {
+		return err
+	}
+	if !mfaEnabled {
+		return respondWithLoginTokens(c, db, jwtUtils, target, amr)
+	}
+
+	challengeToken, err := jwtUtils.GenerateMFAChallengeToken(target.ID, target.Email, amr)
+	if err != nil {
+		return appErrors.NewInternalError(
+			appErrors.ErrorCodeInternalError,
+			"Could not generate MFA challenge token",
+			err,
+		)
+	}
+
+	return response.Success(c, MFAChallengeResponse{
+		MFARequired: true,
+		MFAToken:    challengeToken,
+		ExpiresIn:   int(jwtUtils.MFAChallengeTTL().Seconds()),
+	})
+}
+
+// respondWithLoginTokens는 새 세션을 만들고 액세스/리프레시 토큰을 응답합니다
+func respondWithLoginTokens(c *fiber.Ctx, db *gorm.DB, jwtUtils *jwt.JWTUtils, target *user.User, amr []string) error {
+	// 로그인 성공 시 계정 실패 카운터 초기화
+	if err := throttle.RecordSuccess(db, target.Email); err != nil {
+		log.Printf("Failed to reset login throttle: %v", err)
+	}
+
+	// 세션 및 리프레시 토큰 생성
+	issued, err := session.CreateSession(db, target.ID, c.Get(fiber.HeaderUserAgent), c.IP(), amr)
+	if err != nil {
+		return err
+	}
+
+	// JWT 토큰 생성
+	token, err := jwtUtils.GenerateToken(jwt.TokenParams{
+		UserID:    target.ID,
+		Email:     target.Email,
+		SessionID: issued.Session.ID,
+		AMR:       amr,
+		Roles:     target.RoleList(),
+	})
+	if err != nil {
+		return appErrors.NewInternalError(
+			appErrors.ErrorCodeInternalError,
+			"Could not generate token",
+			err,
+		)
+	}
+
+	// 응답 생성
+	resp := LoginResponse{
+		Token:        token,
+		RefreshToken: issued.RefreshToken,
+		ExpiresIn:    int(jwtUtils.AccessTokenTTL().Seconds()),
+		User: struct {
+			ID    string `json:"id"`
+			Email string `json:"email"`
+		}{ID: target.ID, Email: target.Email},
+	}
+
+	return response.Success(c, resp)
+}
+
+// checkLoginThrottle은 로그인 시도 제한을 확인하고, 차단된 경우 Retry-After 헤더와 감사 로그를 남깁니다
+func checkLoginThrottle(c *fiber.Ctx, db *gorm.DB, config throttle.Config, attempt throttle.Attempt) error {
+	retryAfter, err := throttle.Check(db, config, attempt)
+	if err == nil {
+		return nil
+	}
+
+	if retryAfter > 0 {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	if auditErr := throttle.RecordAudit(db, attempt, throttle.ReasonThrottled); auditErr != nil {
+		log.Printf("Failed to record login audit: %v", auditErr)
+	}
+	return err
+}
 
-		return response.Success(c, resp)
+// recordLoginFailure는 실패 카운터와 감사 로그를 기록합니다. 기록 실패는 로그인 응답에 영향을 주지 않습니다
+func recordLoginFailure(db *gorm.DB, config throttle.Config, attempt throttle.Attempt, reason string) {
+	if err := throttle.RecordFailure(db, config, attempt, reason); err != nil {
+		log.Printf("Failed to record login failure: %v", err)
 	}
 }
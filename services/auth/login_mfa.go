@@ -0,0 +1,103 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/services/auth/core/throttle"
+	"career-log-be/utils/jwt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type LoginMFAInput struct {
+	MFAToken     string `json:"mfa_token" validate:"required"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code" validate:"required_without=Code"`
+}
+
+// HandleLoginMFA는 로그인 챌린지 토큰과 TOTP 코드(또는 복구 코드)를 검증하고 토큰을 발급합니다
+func HandleLoginMFA() fiber.Handler {
+	throttleConfig := throttle.ConfigFromEnv()
+
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
+		input := new(LoginMFAInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		claims, err := jwtUtils.ValidateMFAChallengeToken(input.MFAToken)
+		if err != nil {
+			return appErrors.NewAuthorizationError(
+				appErrors.ErrorCodeInvalidToken,
+				"Invalid or expired MFA token",
+			)
+		}
+
+		var target user.User
+		if err := db.Where("id = ?", claims.ID).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewAuthorizationError(
+					appErrors.ErrorCodeInvalidToken,
+					"Invalid or expired MFA token",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		// 코드 대입 공격 방지를 위해 로그인과 같은 제한을 적용
+		attempt := throttle.Attempt{
+			Email:     target.Email,
+			UserID:    &target.ID,
+			IPAddress: c.IP(),
+			UserAgent: c.Get(fiber.HeaderUserAgent),
+		}
+		if err := checkLoginThrottle(c, db, throttleConfig, attempt); err != nil {
+			return err
+		}
+
+		// 첫 번째 인증 방식에 2단계 인증 방식을 추가
+		var verified bool
+		amr := claims.AMR
+		if input.Code != "" {
+			verified, err = mfa.Verify(db, target.ID, input.Code)
+			amr = append(amr, mfa.AMROTP)
+		} else {
+			verified, err = mfa.UseRecoveryCode(db, target.ID, input.RecoveryCode)
+		}
+		if err != nil {
+			return err
+		}
+
+		if !verified {
+			recordLoginFailure(db, throttleConfig, attempt, throttle.ReasonInvalidMFACode)
+			return appErrors.NewAuthorizationError(
+				appErrors.ErrorCodeInvalidMFACode,
+				"Invalid verification code",
+			)
+		}
+
+		return respondWithLoginTokens(c, db, jwtUtils, &target, append(amr, mfa.AMRMFA))
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/session"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type LogoutInput struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// HandleLogout은 리프레시 토큰이 속한 세션을 폐기합니다.
+// 폐기된 세션에 묶인 액세스 토큰도 더 이상 사용할 수 없습니다.
+func HandleLogout() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		input := new(LogoutInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		if err := session.RevokeByRefreshToken(db, input.RefreshToken); err != nil {
+			return err
+		}
+
+		return response.NoContent(c)
+	}
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"career-log-be/services/auth/core/oauth"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type OAuthAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+// HandleOAuthAuthorize는 외부 제공자의 인가 페이지로 리다이렉트합니다.
+// ?response=json이면 리다이렉트 대신 인가 URL을 반환합니다.
+func HandleOAuthAuthorize() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		registry := c.Locals("oauth").(*oauth.Registry)
+
+		authURL, err := registry.BeginLogin(c.UserContext(), db, c.Params("provider"))
+		if err != nil {
+			return err
+		}
+
+		if c.Query("response") == "json" {
+			return response.Success(c, OAuthAuthorizeResponse{AuthorizationURL: authURL})
+		}
+		return c.Redirect(authURL, fiber.StatusFound)
+	}
+}
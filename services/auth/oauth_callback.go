@@ -0,0 +1,48 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/services/auth/core/oauth"
+	"career-log-be/utils/jwt"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleOAuthCallback은 인가 코드를 교환해 사용자를 찾거나 연결/생성하고 로그인 토큰을 발급합니다
+func HandleOAuthCallback() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
+		registry := c.Locals("oauth").(*oauth.Registry)
+
+		if providerError := c.Query("error"); providerError != "" {
+			return appErrors.NewAuthorizationError(
+				appErrors.ErrorCodeExternalAuthFailed,
+				"Login was cancelled or denied by the provider",
+			)
+		}
+
+		code := c.Query("code")
+		state := c.Query("state")
+		if code == "" || state == "" {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeRequiredField,
+				"code and state are required",
+			)
+		}
+
+		identity, err := registry.CompleteLogin(c.UserContext(), db, c.Params("provider"), code, state)
+		if err != nil {
+			return err
+		}
+
+		target, err := oauth.ResolveUser(db, identity)
+		if err != nil {
+			return err
+		}
+
+		return respondWithLoginResult(c, db, jwtUtils, target, []string{mfa.AMRFederated})
+	}
+}
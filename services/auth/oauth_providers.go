@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"career-log-be/services/auth/core/oauth"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type OAuthProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+// HandleListOAuthProviders는 사용 가능한 외부 로그인 제공자 목록을 반환합니다
+func HandleListOAuthProviders() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		registry := c.Locals("oauth").(*oauth.Registry)
+
+		return response.Success(c, OAuthProvidersResponse{Providers: registry.Names()})
+	}
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/session"
+	"career-log-be/utils/jwt"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type RefreshInput struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type RefreshResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// HandleRefresh는 리프레시 토큰을 교체(rotate)하고 새 액세스 토큰을 발급합니다
+func HandleRefresh() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		jwtUtils := c.Locals("jwt").(*jwt.JWTUtils)
+		input := new(RefreshInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		issued, err := session.RotateRefreshToken(db, input.RefreshToken)
+		if err != nil {
+			return err
+		}
+
+		// 토큰 클레임에 사용할 사용자 조회
+		var user user.User
+		if err := db.Where("id = ?", issued.Session.UserID).First(&user).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewAuthorizationError(
+					appErrors.ErrorCodeInvalidToken,
+					"User no longer exists",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		token, err := jwtUtils.GenerateToken(jwt.TokenParams{
+			UserID:    user.ID,
+			Email:     user.Email,
+			SessionID: issued.Session.ID,
+			AMR:       issued.Session.AuthMethods(),
+			Roles:     user.RoleList(),
+		})
+		if err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeInternalError,
+				"Could not generate token",
+				err,
+			)
+		}
+
+		resp := RefreshResponse{
+			Token:        token,
+			RefreshToken: issued.RefreshToken,
+			ExpiresIn:    int(jwtUtils.AccessTokenTTL().Seconds()),
+		}
+
+		return response.Success(c, resp)
+	}
+}
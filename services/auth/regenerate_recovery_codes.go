@@ -0,0 +1,36 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleRegenerateRecoveryCodes는 기존 복구 코드를 폐기하고 새 복구 코드를 발급합니다
+func HandleRegenerateRecoveryCodes() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		enabled, err := mfa.IsEnabled(db, userID)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Two-factor authentication is not enabled",
+			)
+		}
+
+		codes, err := mfa.RegenerateRecoveryCodes(db, userID)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, RecoveryCodesResponse{RecoveryCodes: codes})
+	}
+}
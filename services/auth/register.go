@@ -3,8 +3,11 @@ package auth
 import (
 	appErrors "career-log-be/errors"
 	"career-log-be/models/user"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/utils/mail"
 	"career-log-be/utils/response"
 	"errors"
+	"log"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -37,6 +40,7 @@ func HandleRegister() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// DB 인스턴스 가져오기
 		db := c.Locals("db").(*gorm.DB)
+		sender := c.Locals("mail").(mail.Sender)
 
 		input := new(RegisterInput)
 
@@ -45,6 +49,7 @@ func HandleRegister() fiber.Handler {
 				"error": "Invalid input",
 			})
 		}
+		input.Email = mail.NormalizeAddress(input.Email)
 
 		// Validate the input
 		if err := validate.Struct(input); err != nil {
@@ -58,8 +63,15 @@ func HandleRegister() fiber.Handler {
 
 		// 이메일 중복 체크
 		var existingUser user.User
-		result := db.Where("email = ?", input.Email).First(&existingUser)
+		result := db.Unscoped().Where("LOWER(email) = ?", input.Email).First(&existingUser)
 		if result.Error == nil {
+			// 탈퇴 처리 중인 계정은 영구 삭제 전까지 이메일을 재사용할 수 없습니다
+			if existingUser.DeletedAt.Valid {
+				return appErrors.NewConflictError(
+					appErrors.ErrorCodeAccountPendingDeletion,
+					"An account with this email is pending deletion",
+				)
+			}
 			return appErrors.NewConflictError(
 				appErrors.ErrorCodeInvalidInput,
 				"Email already exists",
@@ -92,6 +104,11 @@ func HandleRegister() fiber.Handler {
 			})
 		}
 
+		// 인증 메일 발송 (실패해도 가입은 완료되며, 재발송 API로 다시 받을 수 있음)
+		if err := verification.SendVerificationEmail(c.UserContext(), db, sender, user); err != nil {
+			log.Printf("Failed to send verification email to user %s: %v", user.ID, err)
+		}
+
 		// 응답에서 비밀번호 제외
 		resp := RegisterResponse{
 			ID:    user.ID,
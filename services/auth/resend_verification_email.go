@@ -0,0 +1,53 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/utils/mail"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleResendVerificationEmail은 로그인한 사용자에게 인증 메일을 다시 발송합니다
+func HandleResendVerificationEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		sender := c.Locals("mail").(mail.Sender)
+		userID := c.Locals("userID").(string)
+
+		var target user.User
+		if err := db.Where("id = ?", userID).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewNotFoundError(
+					appErrors.ErrorCodeResourceNotFound,
+					"User not found",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		if target.IsEmailVerified() {
+			return appErrors.NewConflictError(
+				appErrors.ErrorCodeResourceConflict,
+				"Email is already verified",
+			)
+		}
+
+		if err := verification.SendVerificationEmail(c.UserContext(), db, sender, &target); err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeInternalError,
+				"Failed to send verification email",
+				err,
+			)
+		}
+
+		return response.Accepted(c, "Verification email has been sent")
+	}
+}
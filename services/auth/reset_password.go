@@ -0,0 +1,101 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/auth/core/pat"
+	"career-log-be/services/auth/core/session"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type ResetPasswordInput struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+// HandleResetPassword는 재설정 토큰을 검증하고 비밀번호를 변경합니다.
+// 변경 후 사용자의 모든 세션을 폐기합니다.
+func HandleResetPassword() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		input := new(ResetPasswordInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeInternalError,
+				"Could not hash password",
+				err,
+			)
+		}
+
+		tx := db.Begin()
+		if tx.Error != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to begin transaction",
+				tx.Error,
+			)
+		}
+
+		actionToken, err := verification.ConsumeToken(tx, input.Token, enums.PasswordResetPurpose)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Model(&user.User{}).
+			Where("id = ?", actionToken.UserID).
+			Update("password", string(hashedPassword)).Error; err != nil {
+			tx.Rollback()
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to update password",
+				err,
+			)
+		}
+
+		// 탈취 가능성에 대비해 기존 세션과 개인 액세스 토큰 모두 폐기
+		if err := session.RevokeAllSessions(tx, actionToken.UserID, ""); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := pat.RevokeAll(tx, actionToken.UserID); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to commit password reset",
+				err,
+			)
+		}
+
+		return response.NoContent(c)
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/services/user/core/account"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type RestoreAccountInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// HandleRestoreAccount는 탈퇴 안내 메일의 복구 토큰으로 영구 삭제 전의 계정을 복구합니다
+func HandleRestoreAccount() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		input := new(RestoreAccountInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		tx := db.Begin()
+		if tx.Error != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to begin transaction",
+				tx.Error,
+			)
+		}
+
+		actionToken, err := verification.ConsumeToken(tx, input.Token, enums.AccountRestorePurpose)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := account.Restore(tx, actionToken.UserID); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to commit account restore",
+				err,
+			)
+		}
+
+		return response.NoContent(c)
+	}
+}
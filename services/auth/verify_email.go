@@ -0,0 +1,78 @@
+package auth
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// HandleVerifyEmail은 이메일 인증 토큰을 검증하고 사용자를 인증 완료 상태로 변경합니다
+func HandleVerifyEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		input := new(VerifyEmailInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		tx := db.Begin()
+		if tx.Error != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to begin transaction",
+				tx.Error,
+			)
+		}
+
+		actionToken, err := verification.ConsumeToken(tx, input.Token, enums.EmailVerificationPurpose)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Model(&user.User{}).
+			Where("id = ? AND email_verified_at IS NULL", actionToken.UserID).
+			Update("email_verified_at", time.Now()).Error; err != nil {
+			tx.Rollback()
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to verify email",
+				err,
+			)
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to commit email verification",
+				err,
+			)
+		}
+
+		return response.NoContent(c)
+	}
+}
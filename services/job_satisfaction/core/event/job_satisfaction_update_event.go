@@ -4,12 +4,16 @@ import (
 	"career-log-be/errors"
 	job_satisfaction "career-log-be/models/job_satisfaction"
 	"career-log-be/models/job_satisfaction/enums"
-	"log"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrNotInitialized는 만족도를 초기화하지 않은 사용자에게 초기화 외의 이벤트를 반영하려 할 때 반환됩니다.
+// 초기화 요청 없이 재시도해도 성공할 수 없습니다
+var ErrNotInitialized = errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "사용자 만족도가 초기화되지 않았습니다")
+
 // ConstrainRange는 값이 0-100 범위를 벗어나지 않도록 제한합니다.
 func ConstrainRange(value float64) float64 {
 	if value < 0 {
@@ -21,48 +25,58 @@ func ConstrainRange(value float64) float64 {
 	return value
 }
 
-// ProcessSatisfactionUpdate는 만족도 변경 이벤트를 처리하고 현재 만족도를 업데이트합니다.
-func ProcessSatisfactionUpdate(db *gorm.DB, event *job_satisfaction.JobSatisfactionUpdateEvent) error {
-	tx := db.Begin()
-	if tx.Error != nil {
-		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "트랜잭션을 시작할 수 없습니다", tx.Error)
+// ApplyEvent는 이벤트 하나의 변화량을 만족도에 반영합니다.
+// 초기화 이벤트는 이전 값을 버리고 이벤트 값으로 새로 시작합니다.
+func ApplyEvent(satisfaction *job_satisfaction.UserJobSatisfaction, event *job_satisfaction.JobSatisfactionUpdateEvent) {
+	if event.EventType == enums.InitEvent {
+		satisfaction.Workload = 0
+		satisfaction.Compensation = 0
+		satisfaction.Growth = 0
+		satisfaction.WorkEnvironment = 0
+		satisfaction.WorkRelationships = 0
+		satisfaction.WorkValues = 0
 	}
 
-	// 트랜잭션 자동 관리
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+	satisfaction.Workload = ConstrainRange(satisfaction.Workload + event.Workload)
+	satisfaction.Compensation = ConstrainRange(satisfaction.Compensation + event.Compensation)
+	satisfaction.Growth = ConstrainRange(satisfaction.Growth + event.Growth)
+	satisfaction.WorkEnvironment = ConstrainRange(satisfaction.WorkEnvironment + event.WorkEnvironment)
+	satisfaction.WorkRelationships = ConstrainRange(satisfaction.WorkRelationships + event.WorkRelationships)
+	satisfaction.WorkValues = ConstrainRange(satisfaction.WorkValues + event.WorkValues)
+}
 
-	// 사용자 만족도 객체 준비
-	var satisfaction job_satisfaction.UserJobSatisfaction
+// ProcessSatisfactionUpdate는 만족도 변경 이벤트를 저장하고 현재 만족도를 업데이트합니다.
+// 호출하는 쪽의 트랜잭션 안에서 실행되며, 이벤트의 생성 시각은 요청(분석) 시각을 유지합니다.
+// 단, 사용자의 마지막 이벤트보다 이르면 마지막 이벤트 시각으로 맞춰 이벤트 로그 순서가 반영 순서와 같도록 합니다.
+// 같은 출처(SourceId)의 같은 타입 이벤트가 이미 반영되었다면 다시 반영하지 않습니다.
+func ProcessSatisfactionUpdate(tx *gorm.DB, event *job_satisfaction.JobSatisfactionUpdateEvent) error {
+	existing, err := FindBySource(tx, event.SourceId, event.EventType)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		event.ID = existing.ID
+		return nil
+	}
 
-	// 이벤트 타입에 따른 처리
-	if event.EventType == enums.InitEvent {
-		// 초기화 이벤트인 경우 새 객체 생성
+	// 기존 만족도 조회 (초기화 이벤트는 없으면 새로 생성)
+	var satisfaction job_satisfaction.UserJobSatisfaction
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", event.UserID).First(&satisfaction)
+	exists := result.Error == nil
+	if result.Error != nil {
+		if result.Error != gorm.ErrRecordNotFound {
+			return errors.NewInternalError(errors.ErrorCodeDatabaseError, "사용자 만족도 조회 중 오류가 발생했습니다", result.Error)
+		}
+		if event.EventType != enums.InitEvent {
+			return ErrNotInitialized
+		}
 		satisfaction = job_satisfaction.UserJobSatisfaction{
 			UserID: event.UserID,
 		}
-	} else {
-		// 업데이트 이벤트인 경우 기존 데이터 조회
-		result := tx.Where("user_id = ?", event.UserID).First(&satisfaction)
-		if result.Error != nil {
-			tx.Rollback()
-			if result.Error == gorm.ErrRecordNotFound {
-				return errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "사용자 만족도가 초기화되지 않았습니다")
-			}
-			return errors.NewInternalError(errors.ErrorCodeDatabaseError, "사용자 만족도 조회 중 오류가 발생했습니다", result.Error)
-		}
 	}
 
 	// 만족도 값 업데이트
-	satisfaction.Workload = ConstrainRange(satisfaction.Workload + event.Workload)
-	satisfaction.Compensation = ConstrainRange(satisfaction.Compensation + event.Compensation)
-	satisfaction.Growth = ConstrainRange(satisfaction.Growth + event.Growth)
-	satisfaction.WorkEnvironment = ConstrainRange(satisfaction.WorkEnvironment + event.WorkEnvironment)
-	satisfaction.WorkRelationships = ConstrainRange(satisfaction.WorkRelationships + event.WorkRelationships)
-	satisfaction.WorkValues = ConstrainRange(satisfaction.WorkValues + event.WorkValues)
+	ApplyEvent(&satisfaction, event)
 
 	// 중요도 값 조회 및 설정
 	var importance job_satisfaction.UserJobSatisfactionImportance
@@ -75,43 +89,78 @@ func ProcessSatisfactionUpdate(db *gorm.DB, event *job_satisfaction.JobSatisfact
 		satisfaction.WorkValuesImportance = importance.WorkValues
 	}
 
-	satisfaction.UpdatedAt = time.Now()
+	now := time.Now().UTC()
+	satisfaction.UpdatedAt = now
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = now
+	}
+	// 초기화 요청은 먼저 들어온 요청보다 앞서 처리되므로, 다시 계산할 때도 같은 순서가 되도록 맞춥니다
+	var lastCreatedAt *time.Time
+	if err := tx.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).
+		Where("user_id = ?", event.UserID).
+		Select("MAX(created_at)").Scan(&lastCreatedAt).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "이벤트 조회 중 오류가 발생했습니다", err)
+	}
+	if lastCreatedAt != nil && event.CreatedAt.Before(*lastCreatedAt) {
+		event.CreatedAt = *lastCreatedAt
+	}
 
 	// 데이터베이스 저장 또는 업데이트
-	var err error
-	if event.EventType == enums.InitEvent {
-		err = tx.Create(&satisfaction).Error
-	} else {
+	if exists {
 		err = tx.Save(&satisfaction).Error
+	} else {
+		err = tx.Create(&satisfaction).Error
 	}
-
 	if err != nil {
-		tx.Rollback()
 		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "사용자 만족도 저장 중 오류가 발생했습니다", err)
 	}
 
 	// 이벤트 저장
 	if err := tx.Create(event).Error; err != nil {
-		tx.Rollback()
 		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "이벤트 저장 중 오류가 발생했습니다", err)
 	}
 
-	return tx.Commit().Error
+	return nil
 }
 
-// PublishJobSatisfactionUpdateEvent는 만족도 업데이트 이벤트를 비동기적으로 처리합니다.
-func PublishJobSatisfactionUpdateEvent(db *gorm.DB, event *job_satisfaction.JobSatisfactionUpdateEvent) {
-	go func() {
-		if err := ProcessSatisfactionUpdate(db, event); err != nil {
-			if appErr, ok := err.(*errors.AppError); ok {
-				log.Printf("만족도 업데이트 이벤트 처리 실패: %s (Code: %s, Type: %s, Debug: %s)",
-					appErr.Message,
-					appErr.Code,
-					appErr.Type,
-					appErr.DebugInfo)
-			} else {
-				log.Printf("만족도 업데이트 이벤트 처리 중 예상치 못한 오류 발생: %v", err)
-			}
+// FindBySource는 출처(SourceId)와 타입이 같은 이벤트를 조회합니다. 출처가 없거나 이벤트가 없으면 nil을 반환합니다
+func FindBySource(tx *gorm.DB, sourceID *string, eventType enums.JobSatisfactionUpdateEventType) (*job_satisfaction.JobSatisfactionUpdateEvent, error) {
+	if sourceID == nil {
+		return nil, nil
+	}
+
+	var existing job_satisfaction.JobSatisfactionUpdateEvent
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("source_id = ? AND event_type = ?", *sourceID, eventType).
+		First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
 		}
-	}()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "이벤트 조회 중 오류가 발생했습니다", err)
+	}
+	return &existing, nil
+}
+
+// DeduplicateSourceEvents는 출처와 타입이 같은 이벤트 중 가장 먼저 생성된 것만 남기고 삭제합니다.
+// 이벤트 테이블에 유일성 제약을 추가하기 전에 실행하며, 이벤트가 삭제된 사용자 ID 목록을 반환합니다.
+func DeduplicateSourceEvents(db *gorm.DB) ([]string, error) {
+	if !db.Migrator().HasTable(&job_satisfaction.JobSatisfactionUpdateEvent{}) {
+		return nil, nil
+	}
+
+	var userIDs []string
+	if err := db.Raw(`
+		WITH deleted AS (
+			DELETE FROM job_satisfaction_update_events e
+			USING job_satisfaction_update_events d
+			WHERE e.source_id IS NOT NULL
+			AND e.source_id = d.source_id
+			AND e.event_type = d.event_type
+			AND (e.created_at, e.id) > (d.created_at, d.id)
+			RETURNING e.user_id
+		)
+		SELECT DISTINCT user_id FROM deleted`).Scan(&userIDs).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "중복 이벤트 삭제 중 오류가 발생했습니다", err)
+	}
+	return userIDs, nil
 }
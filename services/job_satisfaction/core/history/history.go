@@ -0,0 +1,166 @@
+package history
+
+import (
+	"career-log-be/errors"
+	job_satisfaction "career-log-be/models/job_satisfaction"
+	"career-log-be/services/job_satisfaction/core/event"
+	"career-log-be/services/job_satisfaction/core/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Granularity는 이력 집계 단위입니다
+type Granularity string
+
+const (
+	DayGranularity   Granularity = "day"
+	WeekGranularity  Granularity = "week"
+	MonthGranularity Granularity = "month"
+)
+
+// MaxPoints는 한 번에 조회할 수 있는 최대 구간 수입니다
+const MaxPoints = 400
+
+// IsValid - 집계 단위 유효성 검사
+func (g Granularity) IsValid() bool {
+	switch g {
+	case DayGranularity, WeekGranularity, MonthGranularity:
+		return true
+	}
+	return false
+}
+
+// PeriodStart는 t가 속한 구간의 시작 시각(loc 기준 자정)을 반환합니다. 주는 월요일에 시작합니다
+func (g Granularity) PeriodStart(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	switch g {
+	case WeekGranularity:
+		offset := (int(local.Weekday()) + 6) % 7 // 월요일 = 0
+		return time.Date(local.Year(), local.Month(), local.Day()-offset, 0, 0, 0, 0, loc)
+	case MonthGranularity:
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
+
+// Next는 구간 시작 시각의 다음 구간 시작 시각을 반환합니다
+func (g Granularity) Next(start time.Time) time.Time {
+	switch g {
+	case WeekGranularity:
+		return start.AddDate(0, 0, 7)
+	case MonthGranularity:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// Point는 한 구간이 끝난 시점의 직무 만족도입니다
+type Point struct {
+	PeriodStart       time.Time `json:"periodStart"`
+	PeriodEnd         time.Time `json:"periodEnd"`
+	Workload          float64   `json:"workload"`
+	Compensation      float64   `json:"compensation"`
+	Growth            float64   `json:"growth"`
+	WorkEnvironment   float64   `json:"workEnvironment"`
+	WorkRelationships float64   `json:"workRelationships"`
+	WorkValues        float64   `json:"workValues"`
+	Score             float64   `json:"score"`
+	EventCount        int       `json:"eventCount"` // 구간 안에서 발생한 이벤트 수
+}
+
+// Build는 이벤트 스트림을 처음부터 다시 적용하여 [from, to) 구간별 만족도를 계산합니다.
+// 점수(Score)는 현재 중요도 가중치로 계산하며, 첫 이벤트 이전 구간은 결과에 포함하지 않습니다.
+func Build(db *gorm.DB, userID string, from, to time.Time, granularity Granularity, loc *time.Location) ([]Point, error) {
+	periods := 0
+	for start := granularity.PeriodStart(from, loc); start.Before(to); start = granularity.Next(start) {
+		periods++
+		if periods > MaxPoints {
+			return nil, errors.NewValidationError(
+				errors.ErrorCodeInvalidInput,
+				"Validation failed",
+				"requested range is too large for the given granularity",
+			)
+		}
+	}
+
+	var importance job_satisfaction.UserJobSatisfactionImportance
+	if err := db.Where("user_id = ?", userID).First(&importance).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query importance", err)
+	}
+
+	rows, err := db.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).
+		Where("user_id = ? AND created_at < ?", userID, to).
+		Order("created_at asc").
+		Rows()
+	if err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query events", err)
+	}
+	defer rows.Close()
+
+	satisfaction := job_satisfaction.UserJobSatisfaction{
+		WorkloadImportance:          importance.Workload,
+		CompensationImportance:      importance.Compensation,
+		GrowthImportance:            importance.Growth,
+		WorkEnvironmentImportance:   importance.WorkEnvironment,
+		WorkRelationshipsImportance: importance.WorkRelationships,
+		WorkValuesImportance:        importance.WorkValues,
+	}
+	initialized := false
+
+	points := []Point{}
+	periodStart := granularity.PeriodStart(from, loc)
+	periodEnd := granularity.Next(periodStart)
+	eventCount := 0
+
+	// 현재 구간을 닫고 결과에 추가합니다
+	closePeriod := func() {
+		if initialized {
+			points = append(points, newPoint(&satisfaction, periodStart, periodEnd, eventCount))
+		}
+		periodStart = periodEnd
+		periodEnd = granularity.Next(periodStart)
+		eventCount = 0
+	}
+
+	for rows.Next() {
+		var e job_satisfaction.JobSatisfactionUpdateEvent
+		if err := db.ScanRows(rows, &e); err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to read event", err)
+		}
+
+		for !e.CreatedAt.Before(periodEnd) {
+			closePeriod()
+		}
+
+		event.ApplyEvent(&satisfaction, &e)
+		initialized = true
+		if !e.CreatedAt.Before(periodStart) {
+			eventCount++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to read events", err)
+	}
+
+	for periodStart.Before(to) {
+		closePeriod()
+	}
+
+	return points, nil
+}
+
+func newPoint(satisfaction *job_satisfaction.UserJobSatisfaction, start, end time.Time, eventCount int) Point {
+	return Point{
+		PeriodStart:       start,
+		PeriodEnd:         end,
+		Workload:          satisfaction.Workload,
+		Compensation:      satisfaction.Compensation,
+		Growth:            satisfaction.Growth,
+		WorkEnvironment:   satisfaction.WorkEnvironment,
+		WorkRelationships: satisfaction.WorkRelationships,
+		WorkValues:        satisfaction.WorkValues,
+		Score:             utils.CalculateWeightedScore(satisfaction),
+		EventCount:        eventCount,
+	}
+}
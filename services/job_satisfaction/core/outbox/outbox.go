@@ -0,0 +1,241 @@
+package outbox
+
+import (
+	"career-log-be/errors"
+	job_satisfaction "career-log-be/models/job_satisfaction"
+	"career-log-be/models/job_satisfaction/enums"
+	"career-log-be/services/job_satisfaction/core/event"
+	"career-log-be/services/job_satisfaction/core/replay"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// lease는 워커가 가져간 요청을 다른 워커가 다시 가져가기 전까지의 시간입니다 (워커 비정상 종료 대비)
+	lease = 5 * time.Minute
+	// baseBackoff와 maxBackoff는 재시도 대기 시간의 시작값과 상한입니다
+	baseBackoff = 2 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// MaxAttempts는 요청을 처리 중단(DEAD) 상태로 옮기기 전까지의 최대 시도 횟수를 반환합니다
+func MaxAttempts() int {
+	attempts, _ := strconv.Atoi(os.Getenv("OUTBOX_MAX_ATTEMPTS"))
+	if attempts <= 0 {
+		attempts = 12
+	}
+	return attempts
+}
+
+// Workers는 아웃박스를 처리할 워커 수를 반환합니다
+func Workers() int {
+	workers, _ := strconv.Atoi(os.Getenv("OUTBOX_WORKERS"))
+	if workers <= 0 {
+		workers = 4
+	}
+	return workers
+}
+
+// Backoff는 attempts번째 시도가 실패한 뒤 다음 시도까지 기다릴 시간을 반환합니다
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
+
+// Enqueue는 만족도 변경 이벤트를 아웃박스에 저장합니다. 요청에서 함께 저장할 데이터가 있으면 같은 트랜잭션(tx)으로 호출합니다
+func Enqueue(tx *gorm.DB, updateEvent *job_satisfaction.JobSatisfactionUpdateEvent) (*job_satisfaction.JobSatisfactionOutbox, error) {
+	return enqueue(tx, updateEvent, false)
+}
+
+// EnqueueReplacement는 같은 출처의 이전 이벤트 변화량을 대체하는 이벤트를 아웃박스에 저장합니다 (재분석용)
+func EnqueueReplacement(tx *gorm.DB, updateEvent *job_satisfaction.JobSatisfactionUpdateEvent) (*job_satisfaction.JobSatisfactionOutbox, error) {
+	return enqueue(tx, updateEvent, true)
+}
+
+func enqueue(tx *gorm.DB, updateEvent *job_satisfaction.JobSatisfactionUpdateEvent, replace bool) (*job_satisfaction.JobSatisfactionOutbox, error) {
+	// 이벤트 시각은 처리(재시도) 시각이 아닌 요청 시각으로 고정합니다
+	now := time.Now().UTC()
+	if updateEvent.CreatedAt.IsZero() {
+		updateEvent.CreatedAt = now
+	}
+
+	payload, err := json.Marshal(updateEvent)
+	if err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to encode event", err)
+	}
+
+	message := &job_satisfaction.JobSatisfactionOutbox{
+		UserID:        updateEvent.UserID,
+		EventType:     updateEvent.EventType,
+		Payload:       string(payload),
+		Replace:       replace,
+		Status:        enums.PendingOutboxStatus,
+		NextAttemptAt: now,
+	}
+	if err := tx.Create(message).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to enqueue event", err)
+	}
+	return message, nil
+}
+
+// Find는 사용자의 아웃박스 요청을 조회합니다
+func Find(db *gorm.DB, userID, messageID string) (*job_satisfaction.JobSatisfactionOutbox, error) {
+	var message job_satisfaction.JobSatisfactionOutbox
+	if err := db.Where("id = ? AND user_id = ?", messageID, userID).First(&message).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Request not found")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query request", err)
+	}
+	return &message, nil
+}
+
+// HasInProgress는 사용자에게 아직 처리되지 않은 eventType 요청이 있는지 확인합니다
+func HasInProgress(db *gorm.DB, userID string, eventType enums.JobSatisfactionUpdateEventType) (bool, error) {
+	var count int64
+	if err := db.Model(&job_satisfaction.JobSatisfactionOutbox{}).
+		Where("user_id = ? AND event_type = ? AND status IN ?", userID, eventType, inProgressStatuses()).
+		Count(&count).Error; err != nil {
+		return false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query requests", err)
+	}
+	return count > 0, nil
+}
+
+// Claim은 처리할 요청 하나를 가져와 처리 중 상태로 바꿉니다. 처리할 요청이 없으면 nil을 반환합니다.
+//
+// 사용자마다 가장 먼저 들어온 미처리 요청만 가져가므로 같은 사용자의 요청은 들어온 순서대로 하나씩 처리됩니다.
+// 단, 초기화 요청은 같은 사용자의 다른 요청보다 먼저 처리합니다 (초기화 전에 들어온 분석 결과가 초기화를 막지 않도록).
+// 처리 중단(DEAD)된 요청은 뒤의 요청을 막지 않습니다.
+func Claim(db *gorm.DB) (*job_satisfaction.JobSatisfactionOutbox, error) {
+	var claimed *job_satisfaction.JobSatisfactionOutbox
+	err := db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+
+		var message job_satisfaction.JobSatisfactionOutbox
+		result := tx.Raw(`
+			SELECT o.* FROM job_satisfaction_outboxes o
+			WHERE o.id IN (
+				SELECT DISTINCT ON (user_id) id FROM job_satisfaction_outboxes
+				WHERE status IN ?
+				ORDER BY user_id, (event_type <> ?), created_at, id
+			)
+			AND ((o.status = ? AND o.next_attempt_at <= ?) OR (o.status = ? AND o.locked_until < ?))
+			ORDER BY o.next_attempt_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED`,
+			inProgressStatuses(), enums.InitEvent,
+			enums.PendingOutboxStatus, now, enums.ProcessingOutboxStatus, now,
+		).Scan(&message)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		lockedUntil := now.Add(lease)
+		message.Status = enums.ProcessingOutboxStatus
+		message.Attempts++
+		message.LockedUntil = &lockedUntil
+		if err := tx.Model(&message).Updates(map[string]interface{}{
+			"status":       message.Status,
+			"attempts":     message.Attempts,
+			"locked_until": message.LockedUntil,
+		}).Error; err != nil {
+			return err
+		}
+
+		claimed = &message
+		return nil
+	})
+	if err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to claim outbox message", err)
+	}
+	return claimed, nil
+}
+
+// Process는 가져온 요청을 만족도에 반영하고 완료 상태로 기록합니다.
+// 실패하면 재시도를 예약하거나, 최대 시도 횟수를 넘긴 경우 처리 중단(DEAD) 상태로 옮깁니다.
+func Process(db *gorm.DB, message *job_satisfaction.JobSatisfactionOutbox) error {
+	var updateEvent job_satisfaction.JobSatisfactionUpdateEvent
+	if err := json.Unmarshal([]byte(message.Payload), &updateEvent); err != nil {
+		// 잘못된 요청은 재시도해도 성공할 수 없습니다
+		return markFailed(db, message, err, true)
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		apply := event.ProcessSatisfactionUpdate
+		if message.Replace {
+			apply = replay.ReplaceEvent
+		}
+		if err := apply(tx, &updateEvent); err != nil {
+			return err
+		}
+		if updateEvent.EventType == enums.InitEvent {
+			if err := requeueUninitialized(tx, message.UserID); err != nil {
+				return err
+			}
+		}
+
+		processedAt := time.Now().UTC()
+		return tx.Model(message).Updates(map[string]interface{}{
+			"status":       enums.DoneOutboxStatus,
+			"event_id":     updateEvent.ID,
+			"processed_at": processedAt,
+			"locked_until": nil,
+			"last_error":   "",
+		}).Error
+	})
+	if err != nil {
+		// 만족도를 초기화하지 않은 사용자의 요청은 뒤의 요청을 막지 않도록 바로 중단하고, 초기화가 반영되면 다시 처리합니다
+		return markFailed(db, message, err, err == event.ErrNotInitialized)
+	}
+	return nil
+}
+
+// requeueUninitialized는 만족도를 초기화하지 않아 처리 중단된 사용자의 요청을 다시 처리 대기 상태로 돌립니다.
+// 초기화 요청과 같은 트랜잭션에서 호출하며, 다시 처리되는 요청은 들어온 순서대로 초기화 뒤에 반영됩니다
+func requeueUninitialized(tx *gorm.DB, userID string) error {
+	if err := tx.Model(&job_satisfaction.JobSatisfactionOutbox{}).
+		Where("user_id = ? AND status = ? AND last_error = ?", userID, enums.DeadOutboxStatus, event.ErrNotInitialized.Error()).
+		Updates(map[string]interface{}{
+			"status":          enums.PendingOutboxStatus,
+			"attempts":        0,
+			"next_attempt_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to requeue outbox messages", err)
+	}
+	return nil
+}
+
+// markFailed는 실패한 요청의 재시도를 예약하거나 처리 중단 상태로 기록하고, 원래 에러를 반환합니다
+func markFailed(db *gorm.DB, message *job_satisfaction.JobSatisfactionOutbox, cause error, permanent bool) error {
+	updates := map[string]interface{}{
+		"status":          enums.PendingOutboxStatus,
+		"next_attempt_at": time.Now().UTC().Add(Backoff(message.Attempts)),
+		"locked_until":    nil,
+		"last_error":      cause.Error(),
+	}
+	if permanent || message.Attempts >= MaxAttempts() {
+		updates["status"] = enums.DeadOutboxStatus
+	}
+
+	if err := db.Model(message).Updates(updates).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to record outbox failure", err)
+	}
+	return cause
+}
+
+func inProgressStatuses() []enums.OutboxStatus {
+	return []enums.OutboxStatus{enums.PendingOutboxStatus, enums.ProcessingOutboxStatus}
+}
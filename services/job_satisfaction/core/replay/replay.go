@@ -0,0 +1,253 @@
+package replay
+
+import (
+	"career-log-be/errors"
+	job_satisfaction "career-log-be/models/job_satisfaction"
+	"career-log-be/services/job_satisfaction/core/event"
+	"math"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tolerance는 부동소수점 비교 시 같은 값으로 볼 오차입니다
+const tolerance = 1e-9
+
+// Options는 재생(replay) 실행 옵션입니다
+type Options struct {
+	UserID string // 비어 있으면 이벤트가 있는 모든 사용자
+	DryRun bool   // true면 저장하지 않고 차이만 보고합니다
+}
+
+// FieldDiff는 저장된 값과 다시 계산한 값의 차이입니다
+type FieldDiff struct {
+	Stored  float64 `json:"stored"`
+	Rebuilt float64 `json:"rebuilt"`
+}
+
+// UserResult는 사용자 한 명의 재생 결과입니다
+type UserResult struct {
+	UserID     string               `json:"userId"`
+	EventCount int                  `json:"eventCount"`
+	Missing    bool                 `json:"missing"` // 저장된 만족도가 없었는지 여부
+	Diffs      map[string]FieldDiff `json:"diffs,omitempty"`
+}
+
+// Changed는 저장된 만족도와 다시 계산한 만족도가 다른지 확인합니다
+func (r *UserResult) Changed() bool {
+	return r.Missing || len(r.Diffs) > 0
+}
+
+// Report는 재생 실행 결과 요약입니다
+type Report struct {
+	DryRun       bool         `json:"dryRun"`
+	UserCount    int          `json:"userCount"`
+	ChangedCount int          `json:"changedCount"`
+	Changes      []UserResult `json:"changes"` // 차이가 있는 사용자만 포함
+}
+
+// Run은 이벤트 로그로 사용자 직무 만족도를 다시 계산하고, DryRun이 아니면 저장합니다.
+// 사용자마다 별도의 트랜잭션에서 만족도 행을 잠근 뒤 계산하므로 처리 중인 이벤트와 섞이지 않습니다.
+func Run(db *gorm.DB, options Options) (*Report, error) {
+	userIDs := []string{options.UserID}
+	if options.UserID == "" {
+		userIDs = nil
+		if err := db.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).
+			Distinct().
+			Order("user_id").
+			Pluck("user_id", &userIDs).Error; err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query users with events", err)
+		}
+	}
+
+	report := &Report{DryRun: options.DryRun, Changes: []UserResult{}}
+	for _, userID := range userIDs {
+		result, err := replayUser(db, userID, options.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		report.UserCount++
+		if result.Changed() {
+			report.ChangedCount++
+			report.Changes = append(report.Changes, *result)
+		}
+	}
+
+	return report, nil
+}
+
+// Rebuild는 사용자의 이벤트를 생성 순서대로 다시 적용하여 직무 만족도를 계산합니다.
+// 같은 이벤트 로그에 대해서는 항상 같은 결과를 반환합니다.
+func Rebuild(db *gorm.DB, userID string) (*job_satisfaction.UserJobSatisfaction, int, error) {
+	rows, err := db.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).
+		Where("user_id = ?", userID).
+		Order("created_at asc, id asc").
+		Rows()
+	if err != nil {
+		return nil, 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query events", err)
+	}
+	defer rows.Close()
+
+	satisfaction := &job_satisfaction.UserJobSatisfaction{UserID: userID}
+	count := 0
+	for rows.Next() {
+		var e job_satisfaction.JobSatisfactionUpdateEvent
+		if err := db.ScanRows(rows, &e); err != nil {
+			return nil, 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to read event", err)
+		}
+		event.ApplyEvent(satisfaction, &e)
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to read events", err)
+	}
+
+	var importance job_satisfaction.UserJobSatisfactionImportance
+	if err := db.Where("user_id = ?", userID).First(&importance).Error; err == nil {
+		satisfaction.WorkloadImportance = importance.Workload
+		satisfaction.CompensationImportance = importance.Compensation
+		satisfaction.GrowthImportance = importance.Growth
+		satisfaction.WorkEnvironmentImportance = importance.WorkEnvironment
+		satisfaction.WorkRelationshipsImportance = importance.WorkRelationships
+		satisfaction.WorkValuesImportance = importance.WorkValues
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query importance", err)
+	}
+
+	return satisfaction, count, nil
+}
+
+func replayUser(db *gorm.DB, userID string, dryRun bool) (*UserResult, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+	defer tx.Rollback()
+
+	var stored job_satisfaction.UserJobSatisfaction
+	missing := false
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&stored).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query job satisfaction", err)
+		}
+		missing = true
+	}
+
+	rebuilt, eventCount, err := Rebuild(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserResult{
+		UserID:     userID,
+		EventCount: eventCount,
+		Missing:    missing,
+		Diffs:      diff(&stored, rebuilt),
+	}
+	if dryRun || !result.Changed() || eventCount == 0 {
+		return result, nil
+	}
+
+	if missing {
+		err = saveRebuilt(tx, nil, rebuilt)
+	} else {
+		err = saveRebuilt(tx, &stored, rebuilt)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit rebuilt job satisfaction", err)
+	}
+	return result, nil
+}
+
+// ReplaceEvent는 같은 출처(SourceId)의 이전 이벤트 변화량을 새 이벤트의 값으로 바꾸고, 이벤트 로그로 만족도를 다시 계산합니다.
+// 이전 변화량에 새 변화량을 더하지 않고 대체합니다. 이전 이벤트가 없으면 새 이벤트로 반영합니다.
+// 호출하는 쪽의 트랜잭션 안에서 실행됩니다.
+func ReplaceEvent(tx *gorm.DB, updateEvent *job_satisfaction.JobSatisfactionUpdateEvent) error {
+	existing, err := event.FindBySource(tx, updateEvent.SourceId, updateEvent.EventType)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return event.ProcessSatisfactionUpdate(tx, updateEvent)
+	}
+
+	var stored job_satisfaction.UserJobSatisfaction
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", existing.UserID).First(&stored).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return event.ErrNotInitialized
+		}
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query job satisfaction", err)
+	}
+
+	// 이벤트 순서(생성 시각)는 유지하고 변화량만 바꿉니다
+	if err := tx.Model(existing).Updates(map[string]interface{}{
+		"workload":           updateEvent.Workload,
+		"compensation":       updateEvent.Compensation,
+		"growth":             updateEvent.Growth,
+		"work_environment":   updateEvent.WorkEnvironment,
+		"work_relationships": updateEvent.WorkRelationships,
+		"work_values":        updateEvent.WorkValues,
+	}).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to replace event", err)
+	}
+	updateEvent.ID = existing.ID
+
+	rebuilt, _, err := Rebuild(tx, existing.UserID)
+	if err != nil {
+		return err
+	}
+	return saveRebuilt(tx, &stored, rebuilt)
+}
+
+// saveRebuilt는 다시 계산한 만족도를 저장합니다. stored가 nil이면 새로 생성합니다
+func saveRebuilt(tx *gorm.DB, stored, rebuilt *job_satisfaction.UserJobSatisfaction) error {
+	var err error
+	if stored == nil {
+		err = tx.Create(rebuilt).Error
+	} else {
+		rebuilt.ID = stored.ID
+		rebuilt.CreatedAt = stored.CreatedAt
+		err = tx.Save(rebuilt).Error
+	}
+	if err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to save rebuilt job satisfaction", err)
+	}
+	return nil
+}
+
+// diff는 만족도와 중요도 항목별로 저장된 값과 다시 계산한 값을 비교합니다
+func diff(stored, rebuilt *job_satisfaction.UserJobSatisfaction) map[string]FieldDiff {
+	fields := []struct {
+		name    string
+		stored  float64
+		rebuilt float64
+	}{
+		{"workload", stored.Workload, rebuilt.Workload},
+		{"compensation", stored.Compensation, rebuilt.Compensation},
+		{"growth", stored.Growth, rebuilt.Growth},
+		{"workEnvironment", stored.WorkEnvironment, rebuilt.WorkEnvironment},
+		{"workRelationships", stored.WorkRelationships, rebuilt.WorkRelationships},
+		{"workValues", stored.WorkValues, rebuilt.WorkValues},
+		{"workloadImportance", stored.WorkloadImportance, rebuilt.WorkloadImportance},
+		{"compensationImportance", stored.CompensationImportance, rebuilt.CompensationImportance},
+		{"growthImportance", stored.GrowthImportance, rebuilt.GrowthImportance},
+		{"workEnvironmentImportance", stored.WorkEnvironmentImportance, rebuilt.WorkEnvironmentImportance},
+		{"workRelationshipsImportance", stored.WorkRelationshipsImportance, rebuilt.WorkRelationshipsImportance},
+		{"workValuesImportance", stored.WorkValuesImportance, rebuilt.WorkValuesImportance},
+	}
+
+	diffs := map[string]FieldDiff{}
+	for _, field := range fields {
+		if math.Abs(field.stored-field.rebuilt) > tolerance {
+			diffs[field.name] = FieldDiff{Stored: field.stored, Rebuilt: field.rebuilt}
+		}
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+	return diffs
+}
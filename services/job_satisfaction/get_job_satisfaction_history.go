@@ -0,0 +1,101 @@
+package job_satisfaction
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/job_satisfaction/core/history"
+	"career-log-be/services/user/core/profile"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// historyDateLayout은 조회 기간 입력 형식입니다
+const historyDateLayout = "2006-01-02"
+
+// historyDefaultDays는 기간을 지정하지 않았을 때 조회하는 일수입니다
+const historyDefaultDays = 30
+
+type JobSatisfactionHistoryQuery struct {
+	From        string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To          string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+	Granularity string `query:"granularity" validate:"omitempty,oneof=day week month"`
+}
+
+type JobSatisfactionHistoryResponse struct {
+	From        string          `json:"from"`
+	To          string          `json:"to"`
+	Granularity string          `json:"granularity"`
+	Timezone    string          `json:"timezone"`
+	Points      []history.Point `json:"points"`
+}
+
+// HandleGetJobSatisfactionHistory는 이벤트 이력으로 기간별 직무 만족도 추이를 계산하여 반환합니다.
+// 날짜와 구간 경계는 사용자 시간대 기준이며, from/to 날짜를 모두 포함합니다.
+func HandleGetJobSatisfactionHistory() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		query := new(JobSatisfactionHistoryQuery)
+
+		if err := c.QueryParser(query); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid query parameters",
+			)
+		}
+
+		validate := validator.New()
+		if err := validate.Struct(query); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		loc, err := profile.Location(db, userID)
+		if err != nil {
+			return err
+		}
+
+		granularity := history.DayGranularity
+		if query.Granularity != "" {
+			granularity = history.Granularity(query.Granularity)
+		}
+
+		// 기본값: 오늘까지 최근 30일
+		now := time.Now().In(loc)
+		toDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+		if query.To != "" {
+			toDate, _ = time.ParseInLocation(historyDateLayout, query.To, loc)
+		}
+		fromDate := toDate.AddDate(0, 0, -(historyDefaultDays - 1))
+		if query.From != "" {
+			fromDate, _ = time.ParseInLocation(historyDateLayout, query.From, loc)
+		}
+		if fromDate.After(toDate) {
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				"from must not be after to",
+			)
+		}
+
+		points, err := history.Build(db, userID, fromDate, toDate.AddDate(0, 0, 1), granularity, loc)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, JobSatisfactionHistoryResponse{
+			From:        fromDate.Format(historyDateLayout),
+			To:          toDate.Format(historyDateLayout),
+			Granularity: string(granularity),
+			Timezone:    loc.String(),
+			Points:      points,
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package job_satisfaction
+
+import (
+	"career-log-be/models/job_satisfaction/enums"
+	"career-log-be/services/job_satisfaction/core/outbox"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleGetJobSatisfactionRequest는 접수된 만족도 변경 요청의 처리 상태를 조회하는 핸들러입니다
+func HandleGetJobSatisfactionRequest() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		message, err := outbox.Find(db, userID, c.Params("id"))
+		if err != nil {
+			return err
+		}
+
+		switch message.Status {
+		case enums.PendingOutboxStatus, enums.ProcessingOutboxStatus:
+			c.Status(fiber.StatusAccepted)
+		}
+		return response.Success(c, message)
+	}
+}
@@ -4,9 +4,8 @@ import (
 	appErrors "career-log-be/errors"
 	job_satisfaction "career-log-be/models/job_satisfaction"
 	enums "career-log-be/models/job_satisfaction/enums"
-	satisfaction "career-log-be/services/job_satisfaction/core/event"
+	"career-log-be/services/job_satisfaction/core/outbox"
 	"career-log-be/utils/response"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -61,6 +60,18 @@ func HandleInitializeJobSatisfaction() fiber.Handler {
 			)
 		}
 
+		// 이미 처리 대기 중인 초기화 요청이 있는지 확인
+		pending, err := outbox.HasInProgress(db, userID, enums.InitEvent)
+		if err != nil {
+			return err
+		}
+		if pending {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeResourceExists,
+				"Job satisfaction initialization is already in progress",
+			)
+		}
+
 		// 이벤트 생성
 		updateEvent := &job_satisfaction.JobSatisfactionUpdateEvent{
 			UserID:            userID,
@@ -71,12 +82,15 @@ func HandleInitializeJobSatisfaction() fiber.Handler {
 			WorkRelationships: input.WorkRelationships,
 			WorkValues:        input.WorkValues,
 			EventType:         enums.InitEvent,
-			CreatedAt:         time.Now(),
 		}
 
-		// 비동기적으로 이벤트 처리
-		satisfaction.PublishJobSatisfactionUpdateEvent(db, updateEvent)
+		// 아웃박스에 저장 (워커가 비동기로 처리하며, 처리 상태는 /job-satisfaction/requests/:id 로 조회)
+		message, err := outbox.Enqueue(db, updateEvent)
+		if err != nil {
+			return err
+		}
 
-		return response.Accepted(c, "Job satisfaction initialization request has been accepted")
+		c.Status(fiber.StatusAccepted)
+		return response.Success(c, message)
 	}
 }
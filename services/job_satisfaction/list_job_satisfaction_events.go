@@ -0,0 +1,100 @@
+package job_satisfaction
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/job_satisfaction"
+	"career-log-be/models/job_satisfaction/enums"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ListJobSatisfactionEventsQuery struct {
+	Page      int    `query:"page" validate:"omitempty,min=1"`
+	PageSize  int    `query:"pageSize" validate:"omitempty,min=1,max=100"`
+	EventType string `query:"eventType"`
+}
+
+type ListJobSatisfactionEventsResponse struct {
+	Events   []job_satisfaction.JobSatisfactionUpdateEvent `json:"events"`
+	Page     int                                           `json:"page"`
+	PageSize int                                           `json:"pageSize"`
+	Total    int64                                         `json:"total"`
+}
+
+// HandleListJobSatisfactionEvents는 직무 만족도 변경 이벤트를 최신순으로 페이지 단위 조회합니다
+func HandleListJobSatisfactionEvents() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		query := new(ListJobSatisfactionEventsQuery)
+
+		if err := c.QueryParser(query); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid query parameters",
+			)
+		}
+
+		validate := validator.New()
+		if err := validate.Struct(query); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		if query.EventType != "" && !enums.JobSatisfactionUpdateEventType(query.EventType).IsValid() {
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				"unknown eventType: "+query.EventType,
+			)
+		}
+
+		if query.Page == 0 {
+			query.Page = 1
+		}
+		if query.PageSize == 0 {
+			query.PageSize = 20
+		}
+
+		filtered := db.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).Where("user_id = ?", userID)
+		if query.EventType != "" {
+			filtered = filtered.Where("event_type = ?", query.EventType)
+		}
+
+		var total int64
+		if err := filtered.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Error occurred while counting job satisfaction events",
+				err,
+			)
+		}
+
+		events := []job_satisfaction.JobSatisfactionUpdateEvent{}
+		if err := filtered.Session(&gorm.Session{}).
+			Order("created_at desc").
+			Offset((query.Page - 1) * query.PageSize).
+			Limit(query.PageSize).
+			Find(&events).Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Error occurred while retrieving job satisfaction events",
+				err,
+			)
+		}
+
+		return response.Success(c, ListJobSatisfactionEventsResponse{
+			Events:   events,
+			Page:     query.Page,
+			PageSize: query.PageSize,
+			Total:    total,
+		})
+	}
+}
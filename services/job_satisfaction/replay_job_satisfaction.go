@@ -0,0 +1,42 @@
+package job_satisfaction
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/job_satisfaction/core/replay"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ReplayJobSatisfactionInput struct {
+	UserID string `json:"userId"`
+	DryRun bool   `json:"dryRun"`
+}
+
+// HandleReplayJobSatisfaction는 이벤트 로그로 한 명 또는 전체 사용자의 직무 만족도를 재계산하는 핸들러입니다
+func HandleReplayJobSatisfaction() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+
+		input := new(ReplayJobSatisfactionInput)
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(input); err != nil {
+				return appErrors.NewBadRequestError(
+					appErrors.ErrorCodeInvalidInput,
+					"Invalid request body",
+				)
+			}
+		}
+
+		report, err := replay.Run(db, replay.Options{
+			UserID: input.UserID,
+			DryRun: input.DryRun,
+		})
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, report)
+	}
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"career-log-be/services/job_satisfaction/core/outbox"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// pollInterval은 처리할 요청이 없을 때 워커가 다시 확인하기까지 기다리는 시간입니다
+const pollInterval = time.Second
+
+type OutboxWorker struct {
+	db      *gorm.DB
+	workers int
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewOutboxWorker 새로운 OutboxWorker 인스턴스를 생성합니다
+func NewOutboxWorker(db *gorm.DB, workers int) *OutboxWorker {
+	return &OutboxWorker{
+		db:      db,
+		workers: workers,
+	}
+}
+
+// Start 워커들을 시작합니다
+func (ow *OutboxWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ow.cancel = cancel
+
+	for i := 0; i < ow.workers; i++ {
+		ow.wg.Add(1)
+		go func() {
+			defer ow.wg.Done()
+			ow.run(ctx)
+		}()
+	}
+}
+
+// Stop 워커들을 중지합니다. 처리 중인 요청은 끝날 때까지 기다립니다
+func (ow *OutboxWorker) Stop() {
+	if ow.cancel != nil {
+		ow.cancel()
+	}
+	ow.wg.Wait()
+}
+
+// run 중지될 때까지 아웃박스 요청을 하나씩 가져와 처리합니다
+func (ow *OutboxWorker) run(ctx context.Context) {
+	for {
+		processed := ow.processNext()
+
+		// 처리할 요청이 있었다면 바로 다음 요청을 확인합니다
+		wait := pollInterval
+		if processed {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// processNext 요청 하나를 처리하고, 처리할 요청이 있었는지 반환합니다
+func (ow *OutboxWorker) processNext() bool {
+	message, err := outbox.Claim(ow.db)
+	if err != nil {
+		log.Printf("Failed to claim outbox message: %v", err)
+		return false
+	}
+	if message == nil {
+		return false
+	}
+
+	if err := outbox.Process(ow.db, message); err != nil {
+		log.Printf("Failed to process outbox message %s (attempt %d): %v", message.ID, message.Attempts, err)
+	}
+	return true
+}
+
+// InitOutboxWorker Fiber 앱에 아웃박스 워커를 초기화하고 등록하는 함수
+func InitOutboxWorker(app *fiber.App, db *gorm.DB) error {
+	outboxWorker := NewOutboxWorker(db, outbox.Workers())
+	outboxWorker.Start()
+
+	// Fiber 앱이 종료될 때 워커도 함께 종료
+	app.Hooks().OnShutdown(func() error {
+		outboxWorker.Stop()
+		return nil
+	})
+
+	return nil
+}
@@ -4,26 +4,18 @@ import (
 	"career-log-be/services/note/chat/scheduler"
 	"career-log-be/utils/response"
 
-	appErrors "career-log-be/errors"
-
 	"github.com/gofiber/fiber/v2"
-	"gorm.io/gorm"
 )
 
-// HandleAnalyzeDailyChat 일일 채팅 분석을 수동으로 실행하는 핸들러
+// HandleAnalyzeDailyChat 일일 채팅 분석을 수동으로 실행하는 핸들러.
+// ?reanalyze=true 이면 이미 분석한 대화도 다시 분석하여 이전 결과를 대체합니다.
 func HandleAnalyzeDailyChat(c *fiber.Ctx) error {
-	db := c.Locals("db").(*gorm.DB)
+	chatScheduler := c.Locals("chatAnalyzer").(*scheduler.ChatAnalyzeScheduler)
 
-	chatScheduler, err := scheduler.NewChatAnalyzeScheduler(db)
+	run, err := chatScheduler.AnalyzeDailyChat(c.QueryBool("reanalyze"))
 	if err != nil {
-		return appErrors.NewInternalError(
-			appErrors.ErrorCodeInternalError,
-			"Failed to create chat analyzer",
-			err,
-		)
+		return err
 	}
 
-	chatScheduler.AnalyzeDailyChat()
-
-	return response.Accepted(c, "Daily chat analysis has been executed")
+	return response.Success(c, run)
 }
@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"career-log-be/services/note/chat/scheduler"
+	"career-log-be/utils/response"
+
+	appErrors "career-log-be/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type BackfillChatAnalysisInput struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	UserID      string `json:"userId"`
+	Reanalyze   bool   `json:"reanalyze"`
+	ResumeRunID string `json:"resumeRunId"`
+}
+
+// HandleBackfillChatAnalysis 지정한 기간의 분석되지 않은 대화를 백그라운드에서 분석하는 핸들러.
+// resumeRunId를 지정하면 중단된 백필 실행의 옵션으로 이어서 실행합니다.
+func HandleBackfillChatAnalysis(c *fiber.Ctx) error {
+	db := c.Locals("db").(*gorm.DB)
+	input := new(BackfillChatAnalysisInput)
+
+	if err := c.BodyParser(input); err != nil {
+		return appErrors.NewBadRequestError(
+			appErrors.ErrorCodeInvalidInput,
+			"Invalid request body",
+		)
+	}
+
+	options := &scheduler.BackfillOptions{
+		From:      input.From,
+		To:        input.To,
+		UserID:    input.UserID,
+		Reanalyze: input.Reanalyze,
+	}
+	if input.ResumeRunID != "" {
+		resumed, err := scheduler.ResumeBackfillOptions(db, input.ResumeRunID)
+		if err != nil {
+			return err
+		}
+		options = resumed
+	}
+
+	chatScheduler := c.Locals("chatAnalyzer").(*scheduler.ChatAnalyzeScheduler)
+
+	run, err := chatScheduler.StartBackfill(*options)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusAccepted)
+	return response.Success(c, run)
+}
@@ -5,13 +5,15 @@ import (
 	"career-log-be/models/note/chat"
 	"career-log-be/models/note/chat/enums"
 	"career-log-be/models/user"
-	"career-log-be/utils/chatgpt"
-	"context"
+	"career-log-be/services/usage/core/ledger"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/timezone"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/sashabaranov/go-openai"
 	"gorm.io/gorm"
 )
 
@@ -25,7 +27,7 @@ type ChatResponse struct {
 
 func HandleChat(c *fiber.Ctx) error {
 	db := c.Locals("db").(*gorm.DB)
-	chatGPTService := c.Locals("chatgpt").(*chatgpt.Service)
+	provider := c.Locals("llm").(llm.LLMProvider)
 	userID := c.Locals("userID").(string)
 	chatID := c.Params("id")
 	message := c.Query("message")
@@ -62,99 +64,98 @@ func HandleChat(c *fiber.Ctx) error {
 		)
 	}
 
-	// 채팅이 금일 자정을 넘지 않았는지 확인
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	now := time.Now().In(kst)
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, kst)
-	endOfDay := startOfDay.Add(24 * time.Hour)
+	// 채팅이 금일(사용자 시간대 기준) 자정을 넘지 않았는지 확인
+	startOfDay, endOfDay := timezone.DayBounds(time.Now(), userProfile.Location())
 
-	if chatSet.CreatedAt.Before(startOfDay) || chatSet.CreatedAt.After(endOfDay) {
+	if chatSet.CreatedAt.Before(startOfDay) || !chatSet.CreatedAt.Before(endOfDay) {
 		return appErrors.NewBadRequestError(
 			appErrors.ErrorCodeInvalidInput,
 			"Chat is not available after midnight",
 		)
 	}
 
-	// SSE 설정
-	c.Set("Content-Type", "text/event-stream")
-	c.Set("Cache-Control", "no-cache")
-	c.Set("Connection", "keep-alive")
-	c.Set("Transfer-Encoding", "chunked")
-
 	// 사용자 메시지 추가
 	chatSet.ChatData.AddMessage(enums.UserRole, message)
 
-	// ChatGPT 메시지 준비
-	messages := []openai.ChatCompletionMessage{
+	// 상담 메시지 준비
+	messages := []llm.Message{
 		{
-			Role:    "system",
-			Content: getChatPrompt(userProfile.Name),
+			Role:    llm.SystemRole,
+			Content: getChatPrompt(&userProfile),
 		},
 	}
 
 	// 기존 대화 내용 추가
 	for _, msg := range chatSet.ChatData.Messages {
-		messages = append(messages, openai.ChatCompletionMessage{
-			Role:    msg.Role.String(),
+		messages = append(messages, llm.Message{
+			Role:    llm.Role(msg.Role.String()),
 			Content: msg.Content,
 		})
 	}
 
-	// ChatGPT 스트리밍 응답 처리
-	responseChan, errChan := chatGPTService.StreamChatRequest(context.Background(), messages)
-
-	// 전체 응답을 저장할 변수
-	var fullResponse string
-
-	// 스트리밍 응답 전송
-	for {
-		select {
-		case chunk, ok := <-responseChan:
-			if !ok {
-				// 스트리밍 완료
-				chatSet.ChatData.AddMessage(enums.AssistantRole, fullResponse)
-
-				// DB 업데이트
-				if err := db.Save(&chatSet).Error; err != nil {
-					return appErrors.NewInternalError(
-						appErrors.ErrorCodeDatabaseError,
-						"Failed to save chat",
-						err,
-					)
-				}
-
-				// [DONE] 메시지 전송
-				if _, err := c.Write([]byte("data: [DONE]\n\n")); err != nil {
-					return err
-				}
-				return nil
-			}
-
-			if chunk != "" {
-				fullResponse += chunk
-				// 클라이언트에 청크 전송
-				if _, err := c.Write([]byte(fmt.Sprintf("data: %s\n\n", chunk))); err != nil {
-					return appErrors.NewInternalError(
-						"STREAM_ERROR",
-						"Failed to send chunk",
-						fmt.Errorf("error details: %w", err),
-					)
-				}
-			}
-		case err := <-errChan:
-			if err != nil {
-				return appErrors.NewInternalError(
-					"CHATGPT_ERROR",
-					"Failed to get response from ChatGPT",
-					fmt.Errorf("error details: %w", err),
-				)
-			}
+	// 토큰 한도 확인 및 예약 (초과 시 제공자를 호출하지 않음, 동시 요청도 예약을 포함해 확인)
+	quota := c.Locals("quota").(*ledger.QuotaLimiter)
+	request := llm.Request{Task: llm.ChatTask, Messages: messages}
+	reservation, exceeded, err := quota.Reserve(userID, userProfile.Location(), int64(llm.EstimateRequestTokens(request)+ledger.ReservedOutputTokens))
+	if err != nil {
+		return err
+	}
+	if exceeded != nil {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ledger.RetryAfterSeconds(exceeded)))
+		return ledger.QuotaExceededError(exceeded)
+	}
+	// 사용량은 스트리밍이 끝날 때 원장에 기록되므로 그때 예약을 해제합니다
+	defer reservation.Release()
+
+	// SSE 설정
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("Transfer-Encoding", "chunked")
+
+	// 스트리밍 응답 처리 (클라이언트에 청크 전송)
+	var streamErr error
+	response, err := provider.Stream(ledger.WithUser(c.UserContext(), userID, chatSet.ID), request, func(chunk string) error {
+		if _, err := c.Write([]byte(fmt.Sprintf("data: %s\n\n", chunk))); err != nil {
+			streamErr = err
+			return err
 		}
+		return nil
+	})
+	if streamErr != nil {
+		return appErrors.NewInternalError(
+			"STREAM_ERROR",
+			"Failed to send chunk",
+			fmt.Errorf("error details: %w", streamErr),
+		)
+	}
+	if err != nil {
+		// 제공자 장애(*llm.UnavailableError)는 에러 핸들러가 LLM_UNAVAILABLE로, 그 외 실패는 내부 에러로 응답합니다
+		return err
 	}
+
+	// 스트리밍 완료
+	chatSet.ChatData.AddMessage(enums.AssistantRole, response.Content)
+
+	// DB 업데이트
+	if err := db.Save(&chatSet).Error; err != nil {
+		return appErrors.NewInternalError(
+			appErrors.ErrorCodeDatabaseError,
+			"Failed to save chat",
+			err,
+		)
+	}
+
+	// [DONE] 메시지 전송
+	if _, err := c.Write([]byte("data: [DONE]\n\n")); err != nil {
+		return err
+	}
+	return nil
 }
 
-func getChatPrompt(userName string) string {
-	return `
+// getChatPrompt는 상담 시스템 프롬프트를 생성합니다. 프로필의 경력 정보가 있으면 함께 전달합니다
+func getChatPrompt(userProfile *user.UserProfile) string {
+	return fmt.Sprintf(`
 	당신은 내담자의 상담사 역할을 합니다.
 
 	당신은 이야기를 들어주고, 내담자의 현재 상황을 잘 알수 있도록 질문을 해도 되고 공감을 해도 됩니다.
@@ -178,8 +179,38 @@ func getChatPrompt(userName string) string {
 	마지막 내용이, 내담자가 당신에게 한 말이므로, 당신은 그에 대해서 답변을 하거나 공감을 하거나 질문을 이어나가야 합니다.
 	중요한 것은 자연스럽게 이어나가야 하며, 내담자가 이만 종료하고 싶다고 하면 종료해야 합니다.
 
-	내담자를 부르는 호칭은 "${userName}"님 이라고 부르세요. 다만 굳이 부르지 않아도 되는 경우는 부르지 않아도 됩니다.
-	
+	내담자를 부르는 호칭은 "%s"님 이라고 부르세요. 다만 굳이 부르지 않아도 되는 경우는 부르지 않아도 됩니다.
+	%s
 	내담자의 이야기는 다음과 같습니다.
-	`
+	`, userProfile.DisplayName(), getCareerContext(userProfile))
+}
+
+// getCareerContext는 프롬프트에 포함할 내담자의 경력 정보를 만듭니다. 입력된 항목만 포함합니다
+func getCareerContext(userProfile *user.UserProfile) string {
+	var lines []string
+	if userProfile.Organization != "" {
+		lines = append(lines, "- 소속: "+userProfile.Organization)
+	}
+	if userProfile.Team != "" {
+		lines = append(lines, "- 팀: "+userProfile.Team)
+	}
+	if userProfile.JobTitle != "" {
+		lines = append(lines, "- 직무: "+userProfile.JobTitle)
+	}
+	if userProfile.Seniority != "" {
+		lines = append(lines, "- 직급: "+userProfile.Seniority.Label())
+	}
+	if userProfile.StartDate != nil {
+		lines = append(lines, "- 현재 조직 입사일: "+userProfile.StartDate.Format("2006-01-02"))
+	}
+	if userProfile.Industry != "" {
+		lines = append(lines, "- 산업: "+userProfile.Industry)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return `
+	내담자의 경력 정보는 다음과 같습니다. 질문과 공감에 참고하되, 내담자가 먼저 언급하지 않은 정보를 단정하지 마세요.
+	` + strings.Join(lines, "\n\t") + "\n"
 }
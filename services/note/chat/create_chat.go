@@ -4,7 +4,9 @@ import (
 	appErrors "career-log-be/errors"
 	"career-log-be/models/note/chat"
 	"career-log-be/models/note/chat/enums"
+	"career-log-be/services/user/core/profile"
 	"career-log-be/utils/response"
+	"career-log-be/utils/timezone"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -31,11 +33,12 @@ func HandleCreateChat(c *fiber.Ctx) error {
 		)
 	}
 
-	// 오늘 생성한 채팅이 있는지 확인
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	now := time.Now().In(kst)
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, kst)
-	endOfDay := startOfDay.Add(24 * time.Hour)
+	// 오늘(사용자 시간대 기준) 생성한 채팅이 있는지 확인
+	loc, err := profile.Location(db, userID)
+	if err != nil {
+		return err
+	}
+	startOfDay, endOfDay := timezone.DayBounds(time.Now(), loc)
 
 	var existingChat chat.ChatSet
 	if err := db.Where("user_id = ? AND created_at >= ? AND created_at < ?", userID, startOfDay, endOfDay).First(&existingChat).Error; err == nil {
@@ -78,7 +81,7 @@ func HandleCreateChat(c *fiber.Ctx) error {
 				},
 			},
 		},
-		CreatedAt: time.Now().In(kst),
+		CreatedAt: time.Now().UTC(),
 	}
 
 	// DB에 저장
@@ -44,8 +44,7 @@ func HandleCreatePreChat(c *fiber.Ctx) error {
 	}
 
 	// 새로운 PreChat 생성
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	now := time.Now().In(kst)
+	now := time.Now().UTC()
 
 	preChat := chat.PreChat{
 		Content:   req.Content,
@@ -0,0 +1,158 @@
+package scheduler
+
+import (
+	"career-log-be/models/note/chat"
+	chatEnums "career-log-be/models/note/chat/enums"
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/utils/env"
+	"career-log-be/utils/llm"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// AnalysisConfig 대화 분석 워커 풀 설정입니다
+type AnalysisConfig struct {
+	Concurrency       int           // 동시에 분석하는 대화 수
+	RequestsPerMinute int           // 분당 최대 분석 요청 수 (LLM 제공자의 요청 한도에 맞춰 설정)
+	Timeout           time.Duration // 대화 하나를 분석하는 제한 시간 (복구 재시도 포함)
+	MaxAttempts       int           // 응답이 올바르지 않을 때 복구 요청을 포함한 최대 시도 횟수
+}
+
+// AnalysisConfigFromEnv 환경 변수에서 대화 분석 설정을 읽습니다
+func AnalysisConfigFromEnv() AnalysisConfig {
+	return AnalysisConfig{
+		Concurrency:       env.Int("CHAT_ANALYSIS_CONCURRENCY", 4),
+		RequestsPerMinute: env.Int("LLM_REQUESTS_PER_MINUTE", 500),
+		Timeout:           time.Duration(env.Int("CHAT_ANALYSIS_TIMEOUT_SECONDS", 60)) * time.Second,
+		MaxAttempts:       env.Int("CHAT_ANALYSIS_MAX_ATTEMPTS", 3),
+	}
+}
+
+// analysisOutcome 대화 하나의 분석 결과입니다
+type analysisOutcome int
+
+const (
+	analysisSucceeded analysisOutcome = iota
+	analysisFailed
+	analysisCanceled    // 서버 종료로 분석하지 못함 (분석 상태를 바꾸지 않으므로 다음 실행에서 다시 분석)
+	analysisUnavailable // LLM 제공자 장애로 분석하지 못함 (분석 상태를 바꾸지 않음)
+	analysisCircuitOpen // 회로 차단기가 열려 호출하지 못함 (분석 상태를 바꾸지 않고 남은 대화도 분석하지 않음)
+)
+
+// processChats 대화들을 워커 풀로 동시에 분석하고, 결과를 progress에 집계합니다.
+// 분석 요청은 토큰 버킷으로 분당 요청 수를 제한하며, 서버가 종료되면 남은 대화는 분석하지 않고 취소 에러를 반환합니다.
+// LLM 제공자 장애로 회로 차단기가 열리면 남은 대화는 분석 상태를 바꾸지 않은 채 중단하고 에러를 반환합니다.
+func (cs *ChatAnalyzeScheduler) processChats(chatSets []chat.ChatSet, reanalyze bool, progress *jobrun.Progress) error {
+	cs.running.Add(1)
+	defer cs.running.Done()
+
+	ctx, stop := context.WithCancel(cs.ctx)
+	defer stop()
+
+	jobs := make(chan *chat.ChatSet)
+	results := make(chan analysisOutcome)
+
+	var workers sync.WaitGroup
+	for i := 0; i < cs.config.Concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for chatSet := range jobs {
+				results <- cs.processChat(ctx, chatSet, reanalyze)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range chatSets {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- &chatSets[i]:
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	succeeded, failed, canceled, unavailable := 0, 0, 0, 0
+	for outcome := range results {
+		switch outcome {
+		case analysisSucceeded:
+			succeeded++
+			progress.Add(1, 0)
+		case analysisFailed:
+			failed++
+			progress.Add(0, 1)
+		case analysisCanceled:
+			canceled++
+		case analysisUnavailable:
+			unavailable++
+			progress.Skip(1)
+		case analysisCircuitOpen:
+			unavailable++
+			progress.Skip(1)
+			stop()
+		}
+	}
+
+	notStarted := len(chatSets) - succeeded - failed - canceled - unavailable
+	log.Printf("Chat analysis batch finished: %d succeeded, %d failed, %d unavailable, %d canceled, %d not started",
+		succeeded, failed, unavailable, canceled, notStarted)
+	if err := cs.ctx.Err(); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("LLM provider is unavailable; %d chats were left unanalyzed: %w", notStarted, llm.ErrCircuitOpen)
+	}
+	return nil
+}
+
+// processChat 대화 하나를 분석하고 결과를 저장합니다.
+// 분석 응답이 잘못된 경우에만 실패로 기록하고, 제공자 장애는 분석 상태를 바꾸지 않아 이후 실행에서 다시 분석합니다.
+func (cs *ChatAnalyzeScheduler) processChat(batchCtx context.Context, chatSet *chat.ChatSet, reanalyze bool) analysisOutcome {
+	if err := cs.limiter.Wait(batchCtx); err != nil {
+		return analysisCanceled
+	}
+
+	ctx, cancel := context.WithTimeout(batchCtx, cs.config.Timeout)
+	defer cancel()
+
+	event, failedAttempts, err := cs.analyzeChat(ctx, chatSet)
+	cs.recordFailedAttempts(chatSet, failedAttempts)
+	if err != nil {
+		if batchCtx.Err() != nil {
+			return analysisCanceled
+		}
+		var unavailable *llm.UnavailableError
+		if errors.As(err, &unavailable) {
+			log.Printf("Skipped chat %s, LLM provider is unavailable: %v", chatSet.ID, err)
+			if errors.Is(err, llm.ErrCircuitOpen) {
+				return analysisCircuitOpen
+			}
+			return analysisUnavailable
+		}
+		log.Printf("Failed to analyze chat %s: %v", chatSet.ID, err)
+		if err := cs.db.Model(chatSet).Update("analysis_status", chatEnums.FailedAnalysisStatus).Error; err != nil {
+			log.Printf("Failed to mark chat %s as failed: %v", chatSet.ID, err)
+		}
+		return analysisFailed
+	}
+
+	// 분석 결과를 아웃박스에 저장하고 분석 상태를 함께 기록 (워커가 만족도에 반영)
+	if err := cs.saveAnalysis(chatSet, event, reanalyze); err != nil {
+		log.Printf("Failed to save analysis result for chat %s: %v", chatSet.ID, err)
+		return analysisFailed
+	}
+
+	log.Printf("Successfully analyzed and saved result for chat %s", chatSet.ID)
+	return analysisSucceeded
+}
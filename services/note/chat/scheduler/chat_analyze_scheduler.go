@@ -4,30 +4,47 @@ import (
 	"career-log-be/models/job_satisfaction"
 	"career-log-be/models/job_satisfaction/enums"
 	"career-log-be/models/note/chat"
-	"career-log-be/utils/chatgpt"
+	chatEnums "career-log-be/models/note/chat/enums"
+	"career-log-be/models/scheduler"
+	"career-log-be/models/user"
+	"career-log-be/utils/llm"
+	"career-log-be/utils/ratelimit"
+	"career-log-be/utils/timezone"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/go-co-op/gocron"
 	"github.com/gofiber/fiber/v2"
-	"github.com/sashabaranov/go-openai"
 	"gorm.io/gorm"
 
-	satisfaction_event "career-log-be/services/job_satisfaction/core/event"
+	"career-log-be/services/job_satisfaction/core/outbox"
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/services/usage/core/ledger"
 )
 
+// analysisPromptVersion 분석 프롬프트의 버전입니다. 프롬프트를 바꾸면 함께 올립니다
+const analysisPromptVersion = "v1"
+
 type ChatAnalyzeScheduler struct {
 	scheduler *gocron.Scheduler
 	db        *gorm.DB
-	chatGPT   *chatgpt.Service
+	llm       llm.LLMProvider
+	config    AnalysisConfig
+	limiter   *ratelimit.TokenBucket
+
+	// ctx는 서버 종료 시 취소되어 진행 중인 분석을 중단합니다
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running sync.WaitGroup
 }
 
-// ChatGPTAnalysisResponse ChatGPT 응답을 파싱하기 위한 구조체
-type ChatGPTAnalysisResponse struct {
+// AnalysisResponse 분석 응답을 파싱하기 위한 구조체
+type AnalysisResponse struct {
 	Workload          float64 `json:"workload"`
 	Compensation      float64 `json:"compensation"`
 	Growth            float64 `json:"growth"`
@@ -36,6 +53,25 @@ type ChatGPTAnalysisResponse struct {
 	WorkValues        float64 `json:"workValues"`
 }
 
+// analysisSchema 분석 응답의 JSON 스키마입니다. 각 항목은 -10 ~ +10 사이의 점수입니다
+var analysisSchema = llm.Schema{
+	Name:        "job_satisfaction_analysis",
+	Description: "대화에서 평가한 항목별 직무 만족도 점수 (-10 ~ +10)",
+	Definition: json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"workload": {"type": "number", "minimum": -10, "maximum": 10},
+		"compensation": {"type": "number", "minimum": -10, "maximum": 10},
+		"growth": {"type": "number", "minimum": -10, "maximum": 10},
+		"workEnvironment": {"type": "number", "minimum": -10, "maximum": 10},
+		"workRelationships": {"type": "number", "minimum": -10, "maximum": 10},
+		"workValues": {"type": "number", "minimum": -10, "maximum": 10}
+	},
+	"required": ["workload", "compensation", "growth", "workEnvironment", "workRelationships", "workValues"],
+	"additionalProperties": false
+}`),
+}
+
 // getAnalysisPrompt 분석을 위한 프롬프트를 반환합니다
 func getAnalysisPrompt() string {
 	return `당신은 현재 상담자와 내담자의 대화를 분석하여 내담자의 직무 만족도를 평가하는 역할을 합니다.
@@ -74,39 +110,38 @@ func getAnalysisPrompt() string {
 다음은 분석할 대화 내용입니다:`
 }
 
-// analyzeChat 채팅 내용을 분석하여 JobSatisfactionUpdateEvent를 생성합니다
-func (cs *ChatAnalyzeScheduler) analyzeChat(ctx context.Context, chatSet *chat.ChatSet) (*job_satisfaction.JobSatisfactionUpdateEvent, error) {
+// analyzeChat 채팅 내용을 분석하여 JobSatisfactionUpdateEvent를 생성합니다.
+// 응답이 스키마(항목별 -10 ~ +10)를 따르지 않으면 복구 요청으로 다시 시도하며, 실패한 시도들을 함께 반환합니다.
+func (cs *ChatAnalyzeScheduler) analyzeChat(ctx context.Context, chatSet *chat.ChatSet) (*job_satisfaction.JobSatisfactionUpdateEvent, []llm.Attempt, error) {
 	// 대화 내용 구성
 	var conversation string
 	for _, msg := range chatSet.ChatData.Messages {
 		conversation += fmt.Sprintf("%s: %s\n", msg.Role, msg.Content)
 	}
 
-	// ChatGPT 요청
-	messages := []openai.ChatCompletionMessage{
-		{
-			Role:    "system",
-			Content: getAnalysisPrompt(),
-		},
-		{
-			Role:    "user",
-			Content: conversation,
+	// 분석 요청
+	request := llm.Request{
+		Task: llm.AnalysisTask,
+		Messages: []llm.Message{
+			{
+				Role:    llm.SystemRole,
+				Content: getAnalysisPrompt(),
+			},
+			{
+				Role:    llm.UserRole,
+				Content: conversation,
+			},
 		},
 	}
 
-	response, err := cs.chatGPT.CompleteChatRequest(ctx, messages)
+	// 분석 사용량은 대화한 사용자에게 기록
+	ctx = ledger.WithUser(ctx, chatSet.UserID, chatSet.ID)
+	analysis, _, failedAttempts, err := llm.CompleteJSON[AnalysisResponse](ctx, cs.llm, request, analysisSchema, cs.config.MaxAttempts, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get ChatGPT response: %v", err)
-	}
-
-	// 응답 파싱
-	var analysis ChatGPTAnalysisResponse
-	if err := json.Unmarshal([]byte(response), &analysis); err != nil {
-		return nil, fmt.Errorf("failed to parse ChatGPT response: %v", err)
+		return nil, failedAttempts, fmt.Errorf("failed to get analysis response: %w", err)
 	}
 
 	// JobSatisfactionUpdateEvent 생성
-	kst, _ := time.LoadLocation("Asia/Seoul")
 	event := &job_satisfaction.JobSatisfactionUpdateEvent{
 		UserID:            chatSet.UserID,
 		EventType:         enums.ChatAnalysisEvent,
@@ -117,10 +152,10 @@ func (cs *ChatAnalyzeScheduler) analyzeChat(ctx context.Context, chatSet *chat.C
 		WorkRelationships: cs.normalizeScore(analysis.WorkRelationships),
 		WorkValues:        cs.normalizeScore(analysis.WorkValues),
 		SourceId:          &chatSet.ID,
-		CreatedAt:         time.Now().In(kst),
+		CreatedAt:         time.Now().UTC(),
 	}
 
-	return event, nil
+	return event, failedAttempts, nil
 }
 
 func (cs *ChatAnalyzeScheduler) normalizeScore(score float64) float64 {
@@ -128,80 +163,208 @@ func (cs *ChatAnalyzeScheduler) normalizeScore(score float64) float64 {
 }
 
 // NewChatAnalyzeScheduler 새로운 ChatAnalyzeScheduler 인스턴스를 생성합니다
-func NewChatAnalyzeScheduler(db *gorm.DB) (*ChatAnalyzeScheduler, error) {
-	chatGPTService, err := chatgpt.NewChatGPTBuilder().Build()
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ChatGPT service: %v", err)
-	}
-
-	kst, err := time.LoadLocation("Asia/Seoul")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load KST timezone: %v", err)
-	}
-
+func NewChatAnalyzeScheduler(db *gorm.DB, provider llm.LLMProvider) *ChatAnalyzeScheduler {
+	config := AnalysisConfigFromEnv()
+	ctx, cancel := context.WithCancel(context.Background())
 	return &ChatAnalyzeScheduler{
-		scheduler: gocron.NewScheduler(kst),
+		scheduler: gocron.NewScheduler(time.UTC),
 		db:        db,
-		chatGPT:   chatGPTService,
-	}, nil
+		llm:       provider,
+		config:    config,
+		limiter:   ratelimit.NewTokenBucket(config.RequestsPerMinute, config.Concurrency),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
 }
 
 // Start 스케줄러를 시작합니다
 func (cs *ChatAnalyzeScheduler) Start() {
-	// 매일 오전 12시 30분(자정 이후 30분)에 실행
-	_, err := cs.scheduler.Every(1).Day().At("00:30").Do(cs.AnalyzeDailyChat)
+	// 15분마다 실행하여, 현지 시각이 00:30을 지난 시간대의 아직 분석하지 않은 전날 대화를 분석합니다.
+	// 모든 시간대의 UTC 오프셋은 15분 단위이므로 시간대마다 00:30~00:45 사이에 처음 분석됩니다.
+	_, err := cs.scheduler.Cron("*/15 * * * *").Do(cs.AnalyzeDueZones)
 	if err != nil {
-		log.Printf("Failed to schedule daily chat count reset: %v", err)
+		log.Printf("Failed to schedule daily chat analysis: %v", err)
 	}
 
 	cs.scheduler.StartAsync()
 }
 
-// Stop 스케줄러를 중지합니다
+// Stop 스케줄러를 중지하고, 진행 중인 분석을 취소한 뒤 워커가 모두 끝날 때까지 기다립니다
 func (cs *ChatAnalyzeScheduler) Stop() {
 	cs.scheduler.Stop()
+	cs.cancel()
+	cs.running.Wait()
 }
 
-func (cs *ChatAnalyzeScheduler) AnalyzeDailyChat() {
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	now := time.Now().In(kst)
-	// 전날 자정부터
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, kst).AddDate(0, 0, -1)
-	// 당일 자정까지
-	endOfDay := startOfDay.Add(24 * time.Hour)
+// ChatAnalysisJobName 채팅 분석 작업의 이름입니다 (작업 잠금 및 실행 기록에 사용)
+const ChatAnalysisJobName = "chat_analysis"
+
+// AnalyzeDueZones 현지 시각이 분석 시각(00:30)을 지난 시간대 중 아직 분석하지 않은 전날 대화가 있는 시간대를 분석합니다.
+// 시각이 아닌 분석 상태로 대상을 고르므로, 다른 분석이나 백필이 잠금을 가지고 있어 건너뛴 시간대는 다음 실행에서 분석합니다.
+// 여러 서버에서 동시에 실행되어도 작업 잠금을 얻은 한 곳에서만 분석합니다.
+func (cs *ChatAnalyzeScheduler) AnalyzeDueZones() {
+	now := time.Now()
+	var dueZones []string
+	for _, zone := range cs.timezones() {
+		local := now.In(timezone.LoadOrDefault(zone))
+		if local.Hour() == 0 && local.Minute() < 30 {
+			continue
+		}
 
-	// 해당 기간의 ChatSet 조회
-	var chatSets []chat.ChatSet
-	if err := cs.db.Where("created_at >= ? AND created_at < ?", startOfDay, endOfDay).Find(&chatSets).Error; err != nil {
-		log.Printf("Failed to retrieve chat sets: %v", err)
+		var pending int64
+		if err := cs.previousDayQuery(zone, now, pendingChats).Model(&chat.ChatSet{}).Count(&pending).Error; err != nil {
+			log.Printf("Failed to count unanalyzed chats for %s: %v", zone, err)
+			continue
+		}
+		if pending > 0 {
+			dueZones = append(dueZones, zone)
+		}
+	}
+	if len(dueZones) == 0 {
 		return
 	}
 
-	for _, chatSet := range chatSets {
-		event, err := cs.analyzeChat(context.Background(), &chatSet)
-		if err != nil {
-			log.Printf("Failed to analyze chat %s: %v", chatSet.ID, err)
-			continue
+	_, err := jobrun.Run(cs.db, ChatAnalysisJobName, nil, func(progress *jobrun.Progress) error {
+		return cs.analyzeZones(dueZones, now, pendingChats, progress)
+	})
+	if err != nil {
+		log.Printf("Skipped or failed scheduled chat analysis (will retry on the next run): %v", err)
+	}
+}
+
+// AnalyzeDailyChat 모든 시간대에 대해 각 사용자 기준 전날의 대화를 분석합니다 (수동 실행용).
+// 분석하지 않았거나 실패한 대화를 분석하며, reanalyze가 true이면 이미 분석한 대화도 다시 분석하고 이전 분석 결과를 새 결과로 대체합니다.
+// 다른 곳에서 분석이 실행 중이면 충돌 에러를 반환합니다.
+func (cs *ChatAnalyzeScheduler) AnalyzeDailyChat(reanalyze bool) (*scheduler.ScheduledJobRun, error) {
+	now := time.Now()
+	selection := unanalyzedChats
+	if reanalyze {
+		selection = allChats
+	}
+	run, err := jobrun.Run(cs.db, ChatAnalysisJobName, nil, func(progress *jobrun.Progress) error {
+		return cs.analyzeZones(cs.timezones(), now, selection, progress)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Daily chat analysis has been completed")
+	return run, nil
+}
+
+// timezones 사용자 프로필에 설정된 시간대 목록을 반환합니다. 기본 시간대는 항상 포함됩니다
+func (cs *ChatAnalyzeScheduler) timezones() []string {
+	var zones []string
+	if err := cs.db.Model(&user.UserProfile{}).Distinct().Pluck("timezone", &zones).Error; err != nil {
+		log.Printf("Failed to retrieve user timezones: %v", err)
+	}
+
+	for _, zone := range zones {
+		if zone == timezone.DefaultName {
+			return zones
 		}
+	}
+	return append(zones, timezone.DefaultName)
+}
 
-		// DB에 저장
+// chatSelection 분석할 대화의 범위입니다
+type chatSelection int
 
-		satisfaction_event.PublishJobSatisfactionUpdateEvent(cs.db, event)
+const (
+	// pendingChats 아직 분석을 시도하지 않은 대화 (예약 실행, 실패한 대화는 매번 다시 시도하지 않음)
+	pendingChats chatSelection = iota
+	// unanalyzedChats 분석하지 않았거나 분석에 실패한 대화
+	unanalyzedChats
+	// allChats 이미 분석한 대화를 포함한 모든 대화 (재분석)
+	allChats
+)
 
-		log.Printf("Successfully analyzed and saved result for chat %s", chatSet.ID)
+// analyzeZones 각 시간대에 속한 사용자들의 전날(현지 기준) 대화를 모아 분석합니다
+func (cs *ChatAnalyzeScheduler) analyzeZones(zones []string, now time.Time, selection chatSelection, progress *jobrun.Progress) error {
+	var chatSets []chat.ChatSet
+	for _, zone := range zones {
+		var zoneChatSets []chat.ChatSet
+		if err := cs.previousDayQuery(zone, now, selection).Find(&zoneChatSets).Error; err != nil {
+			return fmt.Errorf("failed to retrieve chat sets for %s: %v", zone, err)
+		}
+		chatSets = append(chatSets, zoneChatSets...)
 	}
 
-	log.Println("Daily chat analysis has been completed")
+	progress.SetTotal(len(chatSets))
+	return cs.processChats(chatSets, selection == allChats, progress)
 }
 
-// InitChatAnalyzeScheduler Fiber 앱에 스케줄러를 초기화하고 등록하는 함수
-func InitChatAnalyzeScheduler(app *fiber.App, db *gorm.DB) error {
-	chatScheduler, err := NewChatAnalyzeScheduler(db)
-	if err != nil {
-		return err
+// previousDayQuery 한 시간대에 속한 사용자들의 전날(현지 기준) 대화 중 selection에 해당하는 대화를 조회하는 쿼리를 만듭니다
+func (cs *ChatAnalyzeScheduler) previousDayQuery(zone string, now time.Time, selection chatSelection) *gorm.DB {
+	// 전날 자정부터 당일 자정까지 (현지 기준)
+	startOfDay, endOfDay := timezone.PreviousDayBounds(now, timezone.LoadOrDefault(zone))
+
+	// 해당 시간대 사용자의 ChatSet 조회 (프로필이 없는 사용자는 기본 시간대)
+	query := cs.db.
+		Joins("LEFT JOIN user_profiles ON user_profiles.id = chat_sets.user_id").
+		Where("COALESCE(user_profiles.timezone, ?) = ?", timezone.DefaultName, zone).
+		Where("chat_sets.created_at >= ? AND chat_sets.created_at < ?", startOfDay, endOfDay)
+	switch selection {
+	case pendingChats:
+		query = query.Where("chat_sets.analysis_status = ?", chatEnums.PendingAnalysisStatus)
+	case unanalyzedChats:
+		query = query.Where("chat_sets.analysis_status <> ?", chatEnums.AnalyzedAnalysisStatus)
+	}
+	return query
+}
+
+// saveAnalysis 분석 결과 이벤트를 아웃박스에 저장하고, 같은 트랜잭션에서 대화의 분석 상태를 기록합니다.
+// 재분석이면 이전 분석 결과를 대체하는 이벤트로 저장합니다.
+func (cs *ChatAnalyzeScheduler) saveAnalysis(chatSet *chat.ChatSet, event *job_satisfaction.JobSatisfactionUpdateEvent, reanalyze bool) error {
+	return cs.db.Transaction(func(tx *gorm.DB) error {
+		enqueue := outbox.Enqueue
+		if reanalyze {
+			enqueue = outbox.EnqueueReplacement
+		}
+		if _, err := enqueue(tx, event); err != nil {
+			return err
+		}
+
+		return tx.Model(chatSet).Updates(map[string]interface{}{
+			"analysis_status":         chatEnums.AnalyzedAnalysisStatus,
+			"analyzed_at":             time.Now().UTC(),
+			"analysis_model":          cs.analysisModel(),
+			"analysis_prompt_version": analysisPromptVersion,
+		}).Error
+	})
+}
+
+// recordFailedAttempts 올바르지 않은 응답을 받은 시도들의 원본 응답을 기록합니다
+func (cs *ChatAnalyzeScheduler) recordFailedAttempts(chatSet *chat.ChatSet, failedAttempts []llm.Attempt) {
+	if len(failedAttempts) == 0 {
+		return
 	}
 
+	records := make([]chat.ChatAnalysisAttempt, 0, len(failedAttempts))
+	for i, attempt := range failedAttempts {
+		records = append(records, chat.ChatAnalysisAttempt{
+			ChatSetID:     chatSet.ID,
+			UserID:        chatSet.UserID,
+			Attempt:       i + 1,
+			Model:         cs.analysisModel(),
+			PromptVersion: analysisPromptVersion,
+			RawResponse:   attempt.Raw,
+			Error:         attempt.Error,
+		})
+	}
+
+	if err := cs.db.Create(&records).Error; err != nil {
+		log.Printf("Failed to record analysis attempts for chat %s: %v", chatSet.ID, err)
+	}
+}
+
+// analysisModel 분석 결과에 기록할 제공자와 모델 이름을 반환합니다 (예: openai/gpt-4o-mini)
+func (cs *ChatAnalyzeScheduler) analysisModel() string {
+	return cs.llm.Name() + "/" + cs.llm.Model(llm.AnalysisTask)
+}
+
+// InitChatAnalyzeScheduler Fiber 앱에 스케줄러를 등록하고 시작하는 함수
+func InitChatAnalyzeScheduler(app *fiber.App, chatScheduler *ChatAnalyzeScheduler) error {
 	chatScheduler.Start()
 
 	// Fiber 앱이 종료될 때 스케줄러도 함께 종료
@@ -0,0 +1,168 @@
+package scheduler
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/note/chat"
+	chatEnums "career-log-be/models/note/chat/enums"
+	"career-log-be/models/scheduler"
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/utils/timezone"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ChatBackfillJobName 기간 지정 채팅 분석(백필) 작업의 이름입니다 (실행 기록에 사용).
+	// 같은 대화를 두 번 분석하지 않도록 작업 잠금은 채팅 분석 작업(ChatAnalysisJobName)과 공유합니다
+	ChatBackfillJobName = "chat_backfill"
+	// BackfillDateLayout 백필 기간 입력 형식입니다
+	BackfillDateLayout = "2006-01-02"
+	// backfillMaxDays 한 번에 백필할 수 있는 최대 일수입니다
+	backfillMaxDays = 366
+	// backfillBatchSize 한 번에 조회하는 대화 수입니다
+	backfillBatchSize = 100
+)
+
+// BackfillOptions 백필 실행 옵션입니다
+type BackfillOptions struct {
+	From      string `json:"from"`             // 시작 날짜 (YYYY-MM-DD, 사용자 시간대 기준, 포함)
+	To        string `json:"to"`               // 종료 날짜 (YYYY-MM-DD, 사용자 시간대 기준, 포함)
+	UserID    string `json:"userId,omitempty"` // 비어 있으면 모든 사용자
+	Reanalyze bool   `json:"reanalyze"`        // 이미 분석한 대화도 다시 분석하여 이전 결과를 대체
+	// AnalyzedBefore 재분석 시 이 시각 이후에 분석된 대화는 건너뜁니다 (중단된 재분석을 이어서 실행할 때 사용)
+	AnalyzedBefore *time.Time `json:"analyzedBefore,omitempty"`
+}
+
+// ResumeBackfillOptions 중단된 백필 실행의 옵션을 불러와 이어서 실행할 수 있는 옵션을 만듭니다.
+// 분석 결과는 대화마다 바로 저장되므로, 같은 옵션으로 다시 실행하면 아직 처리하지 않은 대화만 분석합니다.
+func ResumeBackfillOptions(db *gorm.DB, runID string) (*BackfillOptions, error) {
+	run, err := jobrun.Find(db, ChatBackfillJobName, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Params == nil {
+		return nil, errors.NewBadRequestError(errors.ErrorCodeInvalidInput, "Job run has no backfill options")
+	}
+
+	var options BackfillOptions
+	if err := json.Unmarshal([]byte(*run.Params), &options); err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to decode backfill options", err)
+	}
+
+	// 재분석은 원래 실행이 시작된 뒤 분석된 대화를 다시 분석하지 않습니다
+	if options.Reanalyze && options.AnalyzedBefore == nil {
+		startedAt := run.StartedAt
+		options.AnalyzedBefore = &startedAt
+	}
+	return &options, nil
+}
+
+// Backfill 지정한 기간(사용자 시간대 기준)의 대화 중 아직 분석하지 않았거나 분석에 실패한 대화를 분석합니다.
+// Reanalyze가 true이면 이미 분석한 대화도 다시 분석하여 이전 결과를 대체합니다.
+// 진행 상황은 실행 기록(processed/failed/total)에 주기적으로 저장됩니다.
+func (cs *ChatAnalyzeScheduler) Backfill(options BackfillOptions) (*scheduler.ScheduledJobRun, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return jobrun.RunWithLock(cs.db, ChatBackfillJobName, ChatAnalysisJobName, options, cs.backfillJob(options))
+}
+
+// StartBackfill Backfill을 백그라운드에서 실행하고, 시작된 실행 기록을 바로 반환합니다
+func (cs *ChatAnalyzeScheduler) StartBackfill(options BackfillOptions) (*scheduler.ScheduledJobRun, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return jobrun.StartWithLock(cs.db, ChatBackfillJobName, ChatAnalysisJobName, options, cs.backfillJob(options))
+}
+
+// validate 백필 기간을 검사합니다
+func (options BackfillOptions) validate() error {
+	from, err := time.Parse(BackfillDateLayout, options.From)
+	if err != nil {
+		return errors.NewValidationError(errors.ErrorCodeInvalidInput, "Validation failed", "from must be YYYY-MM-DD")
+	}
+	to, err := time.Parse(BackfillDateLayout, options.To)
+	if err != nil {
+		return errors.NewValidationError(errors.ErrorCodeInvalidInput, "Validation failed", "to must be YYYY-MM-DD")
+	}
+	if from.After(to) {
+		return errors.NewValidationError(errors.ErrorCodeInvalidInput, "Validation failed", "from must not be after to")
+	}
+	if to.Sub(from) >= backfillMaxDays*24*time.Hour {
+		return errors.NewValidationError(errors.ErrorCodeInvalidInput, "Validation failed", "date range must not exceed 366 days")
+	}
+	return nil
+}
+
+// backfillJob 백필 대상 대화를 (created_at, id) 순서로 나누어 조회하며 분석하는 작업을 만듭니다
+func (cs *ChatAnalyzeScheduler) backfillJob(options BackfillOptions) func(progress *jobrun.Progress) error {
+	return func(progress *jobrun.Progress) error {
+		query := cs.backfillQuery(options)
+
+		var total int64
+		if err := query.Session(&gorm.Session{}).Model(&chat.ChatSet{}).Count(&total).Error; err != nil {
+			return err
+		}
+		progress.SetTotal(int(total))
+
+		var lastCreatedAt time.Time
+		var lastID string
+		for {
+			batchQuery := query.Session(&gorm.Session{})
+			if lastID != "" {
+				batchQuery = batchQuery.Where("(chat_sets.created_at, chat_sets.id) > (?, ?)", lastCreatedAt, lastID)
+			}
+
+			var chatSets []chat.ChatSet
+			if err := batchQuery.
+				Order("chat_sets.created_at asc, chat_sets.id asc").
+				Limit(backfillBatchSize).
+				Find(&chatSets).Error; err != nil {
+				return err
+			}
+			if len(chatSets) == 0 {
+				return nil
+			}
+
+			if err := cs.processChats(chatSets, options.Reanalyze, progress); err != nil {
+				return err
+			}
+
+			last := chatSets[len(chatSets)-1]
+			lastCreatedAt, lastID = last.CreatedAt, last.ID
+		}
+	}
+}
+
+// backfillQuery 백필 대상 대화를 조회하는 쿼리를 만듭니다. 기간은 시간대마다 현지 자정 기준으로 계산합니다
+func (cs *ChatAnalyzeScheduler) backfillQuery(options BackfillOptions) *gorm.DB {
+	zones := cs.timezones()
+	conditions := make([]string, 0, len(zones))
+	args := make([]interface{}, 0, len(zones)*4)
+	for _, zone := range zones {
+		loc := timezone.LoadOrDefault(zone)
+		start, _ := time.ParseInLocation(BackfillDateLayout, options.From, loc)
+		end, _ := time.ParseInLocation(BackfillDateLayout, options.To, loc)
+
+		conditions = append(conditions, "(COALESCE(user_profiles.timezone, ?) = ? AND chat_sets.created_at >= ? AND chat_sets.created_at < ?)")
+		args = append(args, timezone.DefaultName, zone, start.UTC(), end.AddDate(0, 0, 1).UTC())
+	}
+
+	query := cs.db.
+		Joins("LEFT JOIN user_profiles ON user_profiles.id = chat_sets.user_id").
+		Where(strings.Join(conditions, " OR "), args...)
+	if options.UserID != "" {
+		query = query.Where("chat_sets.user_id = ?", options.UserID)
+	}
+
+	switch {
+	case !options.Reanalyze:
+		query = query.Where("chat_sets.analysis_status <> ?", chatEnums.AnalyzedAnalysisStatus)
+	case options.AnalyzedBefore != nil:
+		query = query.Where("chat_sets.analyzed_at IS NULL OR chat_sets.analyzed_at < ?", *options.AnalyzedBefore)
+	}
+	return query
+}
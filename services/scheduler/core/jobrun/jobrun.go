@@ -0,0 +1,314 @@
+package jobrun
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/scheduler"
+	"career-log-be/models/scheduler/enums"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// saveInterval은 실행 중인 작업의 진행 상황을 실행 기록에 저장하는 최소 간격입니다
+const saveInterval = 2 * time.Second
+
+// Progress는 작업 실행 중 처리 건수를 집계하고, 주기적으로 실행 기록에 저장합니다
+type Progress struct {
+	Total     int
+	Processed int
+	Failed    int
+	Skipped   int
+
+	db      *gorm.DB
+	run     *scheduler.ScheduledJobRun
+	savedAt time.Time
+}
+
+// SetTotal은 처리 대상 건수를 기록합니다
+func (p *Progress) SetTotal(total int) {
+	p.Total = total
+	p.save(true)
+}
+
+// Add는 처리 건수와 실패 건수를 더합니다
+func (p *Progress) Add(processed, failed int) {
+	p.Processed += processed
+	p.Failed += failed
+	p.save(false)
+}
+
+// Skip은 외부 장애 등으로 처리하지 못해 다음 실행으로 미룬 건수를 더합니다
+func (p *Progress) Skip(skipped int) {
+	p.Skipped += skipped
+	p.save(false)
+}
+
+// save는 진행 상황을 실행 기록에 저장합니다. force가 아니면 saveInterval마다 한 번만 저장합니다
+func (p *Progress) save(force bool) {
+	if p.run == nil || (!force && time.Since(p.savedAt) < saveInterval) {
+		return
+	}
+	p.savedAt = time.Now()
+	if err := p.db.Model(p.run).Updates(map[string]interface{}{
+		"total":     p.Total,
+		"processed": p.Processed,
+		"failed":    p.Failed,
+		"skipped":   p.Skipped,
+	}).Error; err != nil {
+		log.Printf("Failed to record job progress %s: %v", p.run.ID, err)
+	}
+}
+
+// lockedRun은 작업 잠금을 얻고 실행 기록을 만든, 아직 끝나지 않은 실행입니다
+type lockedRun struct {
+	db   *gorm.DB
+	conn *sql.Conn
+	lock string
+	key  int64
+	run  *scheduler.ScheduledJobRun
+}
+
+// Run은 Postgres advisory lock으로 작업 잠금을 얻은 경우에만 job을 실행하고 실행 기록을 남깁니다.
+// 여러 서버가 같은 작업을 동시에 실행하려 하면 한 곳에서만 실행되고, 나머지는 충돌 에러를 반환합니다.
+// 잠금은 전용 커넥션의 세션에 걸리므로 서버가 비정상 종료되어도 커넥션이 끊기면 해제됩니다.
+// params가 nil이 아니면 실행 옵션으로 함께 기록합니다.
+func Run(db *gorm.DB, name string, params interface{}, job func(progress *Progress) error) (*scheduler.ScheduledJobRun, error) {
+	return RunWithLock(db, name, name, params, job)
+}
+
+// RunWithLock은 Run과 같지만 작업 이름 대신 lock 이름으로 작업 잠금을 얻습니다.
+// 같은 데이터를 다루는 여러 작업이 같은 잠금을 공유하면 그중 하나만 실행됩니다.
+func RunWithLock(db *gorm.DB, name, lock string, params interface{}, job func(progress *Progress) error) (*scheduler.ScheduledJobRun, error) {
+	locked, err := begin(db, name, lock, params)
+	if err != nil {
+		return nil, err
+	}
+	return locked.finish(job)
+}
+
+// Start는 Run과 같지만 작업 잠금을 얻고 실행 기록을 만든 뒤 작업은 백그라운드에서 실행합니다.
+// 반환하는 실행 기록은 시작 시점의 상태이며, 진행 상황은 실행 기록을 다시 조회하여 확인합니다.
+func Start(db *gorm.DB, name string, params interface{}, job func(progress *Progress) error) (*scheduler.ScheduledJobRun, error) {
+	return StartWithLock(db, name, name, params, job)
+}
+
+// StartWithLock은 Start와 같지만 작업 이름 대신 lock 이름으로 작업 잠금을 얻습니다
+func StartWithLock(db *gorm.DB, name, lock string, params interface{}, job func(progress *Progress) error) (*scheduler.ScheduledJobRun, error) {
+	locked, err := begin(db, name, lock, params)
+	if err != nil {
+		return nil, err
+	}
+
+	started := *locked.run
+	go func() {
+		if _, err := locked.finish(job); err != nil {
+			log.Printf("Job %s (%s) failed: %v", name, started.ID, err)
+		}
+	}()
+	return &started, nil
+}
+
+// begin은 작업 잠금을 얻고 실행 중 상태의 실행 기록을 만듭니다.
+// 잠금을 얻은 뒤에는 같은 잠금으로 실행 중 상태로 남은 이전 실행 기록을 먼저 실패로 정리합니다.
+func begin(db *gorm.DB, name, lock string, params interface{}) (*lockedRun, error) {
+	var encodedParams *string
+	if params != nil {
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to encode job params", err)
+		}
+		value := string(encoded)
+		encodedParams = &value
+	}
+
+	locked, acquired, err := tryLock(db, lock)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, errors.NewConflictError(errors.ErrorCodeResourceConflict, fmt.Sprintf("Job %s is already running", lock))
+	}
+
+	if _, err := reap(db, lock); err != nil {
+		log.Printf("Failed to reap orphaned job runs for %s: %v", lock, err)
+	}
+
+	locked.run = &scheduler.ScheduledJobRun{
+		JobName:   name,
+		LockName:  lock,
+		Instance:  instance,
+		Status:    enums.RunningJobRunStatus,
+		Params:    encodedParams,
+		StartedAt: time.Now().UTC(),
+	}
+	if err := db.Create(locked.run).Error; err != nil {
+		locked.unlock()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to record job run", err)
+	}
+	return locked, nil
+}
+
+// tryLock은 전용 커넥션에서 작업 잠금을 얻으려 시도합니다. 잠금을 얻지 못하면 커넥션을 바로 반환합니다
+func tryLock(db *gorm.DB, lock string) (*lockedRun, bool, error) {
+	ctx := context.Background()
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to get database instance", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to get database connection", err)
+	}
+
+	locked := &lockedRun{db: db, conn: conn, lock: lock, key: lockKey(lock)}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", locked.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to acquire job lock", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+	return locked, true, nil
+}
+
+// ReapOrphaned는 서버가 비정상 종료되어 실행 중(RUNNING) 상태로 남은 실행 기록을 실패(FAILED)로 기록합니다.
+// 작업 잠금을 얻을 수 있으면 그 잠금으로 실행 중인 작업이 없으므로, 남아 있는 실행 중 기록은 중단된 실행입니다.
+// 다른 서버에서 실행 중인 작업의 기록은 건드리지 않으며, 정리한 실행 기록 수를 반환합니다.
+func ReapOrphaned(db *gorm.DB) (int64, error) {
+	var locks []string
+	if err := db.Model(&scheduler.ScheduledJobRun{}).
+		Where("status = ?", enums.RunningJobRunStatus).
+		Distinct().
+		Pluck("COALESCE(NULLIF(lock_name, ''), job_name)", &locks).Error; err != nil {
+		return 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query running job runs", err)
+	}
+
+	var reaped int64
+	for _, lock := range locks {
+		locked, acquired, err := tryLock(db, lock)
+		if err != nil {
+			return reaped, err
+		}
+		if !acquired {
+			continue
+		}
+		count, err := reap(db, lock)
+		locked.unlock()
+		if err != nil {
+			return reaped, err
+		}
+		reaped += count
+	}
+	return reaped, nil
+}
+
+// reap은 lock 잠금으로 실행 중 상태로 남은 실행 기록을 실패로 기록합니다. 호출하는 쪽이 잠금을 가지고 있어야 합니다
+func reap(db *gorm.DB, lock string) (int64, error) {
+	result := db.Model(&scheduler.ScheduledJobRun{}).
+		Where("status = ? AND COALESCE(NULLIF(lock_name, ''), job_name) = ?", enums.RunningJobRunStatus, lock).
+		Updates(map[string]interface{}{
+			"status":      enums.FailedJobRunStatus,
+			"error":       "Job run was interrupted before it finished",
+			"finished_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to reap orphaned job runs", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// finish는 작업을 실행하고 결과를 실행 기록에 남긴 뒤 작업 잠금을 해제합니다
+func (l *lockedRun) finish(job func(progress *Progress) error) (*scheduler.ScheduledJobRun, error) {
+	defer l.unlock()
+
+	run := l.run
+	progress := &Progress{db: l.db, run: run, savedAt: time.Now()}
+	jobErr := runJob(job, progress)
+
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.Total = progress.Total
+	run.Processed = progress.Processed
+	run.Failed = progress.Failed
+	run.Skipped = progress.Skipped
+	run.Status = enums.SucceededJobRunStatus
+	if jobErr != nil {
+		run.Status = enums.FailedJobRunStatus
+		run.Error = jobErr.Error()
+	}
+	if err := l.db.Save(run).Error; err != nil {
+		log.Printf("Failed to record job run result %s: %v", run.ID, err)
+	}
+
+	return run, jobErr
+}
+
+// unlock은 작업 잠금을 해제하고 전용 커넥션을 반환합니다
+func (l *lockedRun) unlock() {
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		log.Printf("Failed to release job lock %s: %v", l.lock, err)
+	}
+	l.conn.Close()
+}
+
+// Find는 작업의 실행 기록 하나를 조회합니다
+func Find(db *gorm.DB, name, runID string) (*scheduler.ScheduledJobRun, error) {
+	var run scheduler.ScheduledJobRun
+	if err := db.Where("id = ? AND job_name = ?", runID, name).First(&run).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Job run not found")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query job run", err)
+	}
+	return &run, nil
+}
+
+// List는 최근 실행 기록을 최신순으로 조회합니다. name이 비어 있으면 모든 작업을 조회합니다
+func List(db *gorm.DB, name string, limit int) ([]scheduler.ScheduledJobRun, error) {
+	query := db.Order("started_at desc, id desc").Limit(limit)
+	if name != "" {
+		query = query.Where("job_name = ?", name)
+	}
+
+	var runs []scheduler.ScheduledJobRun
+	if err := query.Find(&runs).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query job runs", err)
+	}
+	return runs, nil
+}
+
+// runJob은 작업을 실행하고, 패닉이 발생하면 에러로 바꿔 실행 기록에 남깁니다
+func runJob(job func(progress *Progress) error, progress *Progress) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job(progress)
+}
+
+// lockKey는 작업 이름으로 advisory lock 키를 만듭니다
+func lockKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte("career-log:job:" + name))
+	return int64(hash.Sum64())
+}
+
+// instance는 실행 기록에 남길 현재 서버 식별자입니다
+var instance = func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", hostname, os.Getpid())
+}()
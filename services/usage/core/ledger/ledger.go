@@ -0,0 +1,53 @@
+// Package ledger records LLM token usage per call and enforces per-user token quotas
+package ledger
+
+import (
+	"context"
+	"log"
+
+	"career-log-be/models/usage"
+	"career-log-be/utils/llm"
+
+	"gorm.io/gorm"
+)
+
+type callerKey struct{}
+
+type caller struct {
+	userID   string
+	sourceID string
+}
+
+// WithUser는 ctx로 호출하는 LLM 요청의 사용량을 userID와 sourceID(대화 ID)로 기록하도록 표시합니다
+func WithUser(ctx context.Context, userID, sourceID string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller{userID: userID, sourceID: sourceID})
+}
+
+// NewRecorder는 LLM 호출 사용량을 원장에 기록하는 llm.UsageRecorder를 생성합니다.
+// 기록에 실패해도 호출은 성공으로 처리하고 로그만 남깁니다.
+func NewRecorder(db *gorm.DB, pricing Pricing) llm.UsageRecorder {
+	return func(ctx context.Context, task llm.Task, provider string, response *llm.Response) {
+		entry := &usage.LLMUsage{
+			Task:         string(task),
+			Provider:     provider,
+			Model:        response.Model,
+			InputTokens:  response.Usage.InputTokens,
+			OutputTokens: response.Usage.OutputTokens,
+			TotalTokens:  response.Usage.InputTokens + response.Usage.OutputTokens,
+			CostUSD:      pricing.Cost(response.Model, response.Usage),
+			Estimated:    response.Usage.Estimated,
+		}
+		if c, ok := ctx.Value(callerKey{}).(caller); ok {
+			if c.userID != "" {
+				entry.UserID = &c.userID
+			}
+			if c.sourceID != "" {
+				entry.SourceID = &c.sourceID
+			}
+		}
+
+		if err := db.Create(entry).Error; err != nil {
+			log.Printf("Failed to record LLM usage (%s %s): %v", provider, response.Model, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ledger
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"career-log-be/utils/llm"
+)
+
+// Price는 모델의 100만 토큰당 가격(USD)입니다
+type Price struct {
+	Input  float64
+	Output float64
+}
+
+// Pricing은 모델 이름별 가격표입니다. 응답의 모델 이름은 날짜 접미사가 붙을 수 있으므로
+// (예: gpt-4o-mini-2024-07-18) 가장 길게 일치하는 접두사의 가격을 사용합니다.
+type Pricing map[string]Price
+
+// defaultPricing은 기본 가격표입니다. 가격이 바뀌면 LLM_PRICING으로 덮어씁니다
+var defaultPricing = Pricing{
+	"gpt-4o-mini":       {Input: 0.15, Output: 0.60},
+	"gpt-4o":            {Input: 2.50, Output: 10.00},
+	"gpt-4.1-mini":      {Input: 0.40, Output: 1.60},
+	"gpt-4.1":           {Input: 2.00, Output: 8.00},
+	"claude-haiku-4-5":  {Input: 1.00, Output: 5.00},
+	"claude-sonnet-4-5": {Input: 3.00, Output: 15.00},
+}
+
+// PricingFromEnv는 기본 가격표에 LLM_PRICING 환경 변수의 가격을 더합니다.
+// 형식: "모델=입력가격:출력가격" 을 쉼표로 구분 (100만 토큰당 USD, 예: "llama3.1=0:0,gpt-4o=2.5:10")
+func PricingFromEnv() Pricing {
+	pricing := make(Pricing, len(defaultPricing))
+	for model, price := range defaultPricing {
+		pricing[model] = price
+	}
+
+	for _, entry := range strings.Split(os.Getenv("LLM_PRICING"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		model, prices, ok := strings.Cut(entry, "=")
+		input, output, ok2 := strings.Cut(prices, ":")
+		inputPrice, err := strconv.ParseFloat(input, 64)
+		outputPrice, err2 := strconv.ParseFloat(output, 64)
+		if !ok || !ok2 || err != nil || err2 != nil {
+			log.Printf("Ignoring invalid LLM_PRICING entry: %q", entry)
+			continue
+		}
+		pricing[strings.TrimSpace(model)] = Price{Input: inputPrice, Output: outputPrice}
+	}
+	return pricing
+}
+
+// Cost는 사용량의 예상 비용(USD)을 계산합니다. 가격표에 없는 모델은 0입니다
+func (p Pricing) Cost(model string, usage llm.Usage) float64 {
+	price, matched := Price{}, ""
+	for name, candidate := range p {
+		if strings.HasPrefix(model, name) && len(name) > len(matched) {
+			price, matched = candidate, name
+		}
+	}
+
+	return (float64(usage.InputTokens)*price.Input + float64(usage.OutputTokens)*price.Output) / 1_000_000
+}
@@ -0,0 +1,194 @@
+package ledger
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"career-log-be/errors"
+	"career-log-be/models/usage"
+	"career-log-be/utils/env"
+	"career-log-be/utils/llm"
+
+	"gorm.io/gorm"
+)
+
+// reservationTTL은 예약이 한도 계산에 포함되는 최대 시간입니다 (재시도를 포함한 응답 하나보다 충분히 길게)
+const reservationTTL = 10 * time.Minute
+
+// ReservedOutputTokens는 응답 길이를 알기 전에 예약하는 출력 토큰 수입니다
+const ReservedOutputTokens = 1024
+
+// QuotaConfig는 사용자별 상담 대화 토큰 한도입니다 (0이면 제한 없음)
+type QuotaConfig struct {
+	DailyTokens   int64
+	MonthlyTokens int64
+}
+
+// QuotaConfigFromEnv는 환경 변수에서 토큰 한도를 읽습니다
+func QuotaConfigFromEnv() QuotaConfig {
+	return QuotaConfig{
+		DailyTokens:   int64(env.NonNegativeInt("LLM_DAILY_TOKEN_QUOTA", 0)),
+		MonthlyTokens: int64(env.NonNegativeInt("LLM_MONTHLY_TOKEN_QUOTA", 0)),
+	}
+}
+
+// QuotaStatus는 한도 기간 하나의 사용 현황입니다
+type QuotaStatus struct {
+	Period   string    `json:"period"` // daily, monthly
+	Limit    int64     `json:"limit"`
+	Used     int64     `json:"used"`     // 기록된 사용량
+	Reserved int64     `json:"reserved"` // 진행 중인 응답이 예약한 토큰
+	ResetAt  time.Time `json:"resetAt"`
+}
+
+// Exceeded는 한도를 모두 사용했는지 반환합니다 (진행 중인 응답의 예약 포함)
+func (s QuotaStatus) Exceeded() bool {
+	return s.Used+s.Reserved >= s.Limit
+}
+
+// QuotaLimiter는 사용자별 상담 대화 토큰 한도를 확인하고, 제공자를 호출하기 전에 토큰을 예약합니다
+type QuotaLimiter struct {
+	db     *gorm.DB
+	config QuotaConfig
+}
+
+// NewQuotaLimiter는 새로운 QuotaLimiter를 생성합니다
+func NewQuotaLimiter(db *gorm.DB, config QuotaConfig) *QuotaLimiter {
+	return &QuotaLimiter{db: db, config: config}
+}
+
+// Reservation은 진행 중인 응답 하나의 토큰 예약입니다
+type Reservation struct {
+	db *gorm.DB
+	id string
+}
+
+// Release는 예약을 해제합니다. 실제 사용량은 원장에 기록되므로 응답이 끝나면 호출합니다
+func (r *Reservation) Release() {
+	if r == nil || r.id == "" {
+		return
+	}
+	if err := r.db.Where("id = ?", r.id).Delete(&usage.LLMQuotaReservation{}).Error; err != nil {
+		log.Printf("Failed to release quota reservation %s: %v", r.id, err)
+	}
+}
+
+// Statuses는 설정된 한도마다 사용자의 상담 대화 토큰 사용 현황을 반환합니다.
+// 일/월 경계는 사용자 시간대(loc) 기준이며, 분석 작업의 토큰은 한도에 포함하지 않습니다.
+func (l *QuotaLimiter) Statuses(userID string, loc *time.Location, now time.Time) ([]QuotaStatus, error) {
+	return quotaStatuses(l.db, userID, loc, l.config, now)
+}
+
+// Reserve는 한도가 남아 있으면 tokens만큼 예약하고, 모두 사용했으면 가장 늦게 초기화되는 기간의 현황을 반환합니다.
+// 같은 사용자의 예약은 Postgres advisory lock으로 직렬화하므로, 동시에 요청해도 다른 응답의 예약까지 포함해 확인합니다.
+func (l *QuotaLimiter) Reserve(userID string, loc *time.Location, tokens int64) (*Reservation, *QuotaStatus, error) {
+	if l.config.DailyTokens <= 0 && l.config.MonthlyTokens <= 0 {
+		return nil, nil, nil
+	}
+
+	now := time.Now()
+	var reservation *Reservation
+	var exceeded *QuotaStatus
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		// 트랜잭션이 끝나면 잠금이 자동으로 해제됩니다
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "llm_quota:"+userID).Error; err != nil {
+			return err
+		}
+
+		// 만료된 예약 정리 (서버가 응답 도중 중단된 경우)
+		if err := tx.Where("user_id = ? AND expires_at <= ?", userID, now).Delete(&usage.LLMQuotaReservation{}).Error; err != nil {
+			return err
+		}
+
+		statuses, err := quotaStatuses(tx, userID, loc, l.config, now)
+		if err != nil {
+			return err
+		}
+		for i := range statuses {
+			if statuses[i].Exceeded() && (exceeded == nil || statuses[i].ResetAt.After(exceeded.ResetAt)) {
+				exceeded = &statuses[i]
+			}
+		}
+		if exceeded != nil {
+			return nil
+		}
+
+		row := &usage.LLMQuotaReservation{UserID: userID, Tokens: tokens, ExpiresAt: now.Add(reservationTTL)}
+		if err := tx.Create(row).Error; err != nil {
+			return err
+		}
+		reservation = &Reservation{db: l.db, id: row.ID}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to reserve token quota", err)
+	}
+	return reservation, exceeded, nil
+}
+
+func quotaStatuses(db *gorm.DB, userID string, loc *time.Location, config QuotaConfig, now time.Time) ([]QuotaStatus, error) {
+	local := now.In(loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	monthStart := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+
+	periods := []struct {
+		name  string
+		limit int64
+		start time.Time
+		end   time.Time
+	}{
+		{"daily", config.DailyTokens, dayStart, dayStart.AddDate(0, 0, 1)},
+		{"monthly", config.MonthlyTokens, monthStart, monthStart.AddDate(0, 1, 0)},
+	}
+
+	// 진행 중인 응답은 어느 기간에든 사용량으로 더해집니다
+	var reserved int64
+	if err := db.Model(&usage.LLMQuotaReservation{}).
+		Select("COALESCE(SUM(tokens), 0)").
+		Where("user_id = ? AND expires_at > ?", userID, now).
+		Scan(&reserved).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query token reservations", err)
+	}
+
+	statuses := []QuotaStatus{}
+	for _, period := range periods {
+		if period.limit <= 0 {
+			continue
+		}
+
+		var used int64
+		err := db.Model(&usage.LLMUsage{}).
+			Select("COALESCE(SUM(total_tokens), 0)").
+			Where("user_id = ? AND task = ? AND created_at >= ? AND created_at < ?", userID, string(llm.ChatTask), period.start.UTC(), period.end.UTC()).
+			Scan(&used).Error
+		if err != nil {
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query token usage", err)
+		}
+
+		statuses = append(statuses, QuotaStatus{Period: period.name, Limit: period.limit, Used: used, Reserved: reserved, ResetAt: period.end.UTC()})
+	}
+	return statuses, nil
+}
+
+// QuotaExceededError는 토큰 한도 초과 에러를 생성합니다
+func QuotaExceededError(status *QuotaStatus) *errors.AppError {
+	return errors.NewRateLimitedError(
+		errors.ErrorCodeQuotaExceeded,
+		fmt.Sprintf("The %s token quota has been exceeded", status.Period),
+		map[string]interface{}{
+			"period":            status.Period,
+			"limit":             status.Limit,
+			"used":              status.Used,
+			"reserved":          status.Reserved,
+			"resetAt":           status.ResetAt,
+			"retryAfterSeconds": RetryAfterSeconds(status),
+		},
+	)
+}
+
+// RetryAfterSeconds는 한도가 초기화될 때까지 남은 시간(초)을 반환합니다
+func RetryAfterSeconds(status *QuotaStatus) int {
+	return int(math.Ceil(time.Until(status.ResetAt).Seconds()))
+}
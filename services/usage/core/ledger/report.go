@@ -0,0 +1,95 @@
+package ledger
+
+import (
+	"time"
+
+	"career-log-be/errors"
+	"career-log-be/models/usage"
+
+	"gorm.io/gorm"
+)
+
+// Summary는 기간 내 LLM 사용량 합계입니다
+type Summary struct {
+	Calls        int64   `json:"calls"`
+	InputTokens  int64   `json:"inputTokens"`
+	OutputTokens int64   `json:"outputTokens"`
+	TotalTokens  int64   `json:"totalTokens"`
+	CostUSD      float64 `json:"costUsd"`
+}
+
+// GroupUsage는 그룹(작업, 날짜, 사용자, 모델 등)별 사용량 합계입니다
+type GroupUsage struct {
+	Key string `json:"key"`
+	Summary
+}
+
+// summaryColumns는 Summary로 집계하는 SELECT 항목입니다
+const summaryColumns = "COUNT(*) AS calls, " +
+	"COALESCE(SUM(input_tokens), 0) AS input_tokens, " +
+	"COALESCE(SUM(output_tokens), 0) AS output_tokens, " +
+	"COALESCE(SUM(total_tokens), 0) AS total_tokens, " +
+	"COALESCE(SUM(cost_usd), 0) AS cost_usd"
+
+// ReportGroups는 관리자 보고서에서 묶을 수 있는 기준과 컬럼입니다
+var ReportGroups = map[string]string{
+	"user":     "COALESCE(user_id, '')",
+	"model":    "model",
+	"task":     "task",
+	"provider": "provider",
+}
+
+// UserUsage는 사용자의 기간 내 사용량 합계, 작업별 합계, 일별 합계(사용자 시간대 기준)를 반환합니다
+func UserUsage(db *gorm.DB, userID string, from, to time.Time, loc *time.Location) (Summary, []GroupUsage, []GroupUsage, error) {
+	query := func() *gorm.DB {
+		return db.Model(&usage.LLMUsage{}).Where("user_id = ? AND created_at >= ? AND created_at < ?", userID, from.UTC(), to.UTC())
+	}
+
+	var total Summary
+	if err := query().Select(summaryColumns).Scan(&total).Error; err != nil {
+		return Summary{}, nil, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query usage", err)
+	}
+
+	byTask, err := groupBy(query(), "task")
+	if err != nil {
+		return Summary{}, nil, nil, err
+	}
+
+	daily, err := groupBy(query(), "to_char(created_at AT TIME ZONE ?, 'YYYY-MM-DD')", loc.String())
+	if err != nil {
+		return Summary{}, nil, nil, err
+	}
+
+	return total, byTask, daily, nil
+}
+
+// Report는 전체 사용자의 기간 내 사용량을 group 기준으로 묶어 비용이 큰 순서로 최대 limit개 반환합니다
+func Report(db *gorm.DB, from, to time.Time, group string, limit int) (Summary, []GroupUsage, error) {
+	query := func() *gorm.DB {
+		return db.Model(&usage.LLMUsage{}).Where("created_at >= ? AND created_at < ?", from.UTC(), to.UTC())
+	}
+
+	var total Summary
+	if err := query().Select(summaryColumns).Scan(&total).Error; err != nil {
+		return Summary{}, nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query usage", err)
+	}
+
+	rows, err := groupBy(query().Order("cost_usd DESC, total_tokens DESC").Limit(limit), ReportGroups[group])
+	if err != nil {
+		return Summary{}, nil, err
+	}
+	return total, rows, nil
+}
+
+// groupBy는 key 표현식으로 묶은 사용량 합계를 반환합니다. 정렬을 지정하지 않았으면 key 순서입니다
+func groupBy(query *gorm.DB, key string, args ...interface{}) ([]GroupUsage, error) {
+	if _, ordered := query.Statement.Clauses["ORDER BY"]; !ordered {
+		query = query.Order("key")
+	}
+
+	rows := []GroupUsage{}
+	if err := query.Select(key+" AS key, "+summaryColumns, args...).Group("key").Scan(&rows).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query usage", err)
+	}
+	return rows, nil
+}
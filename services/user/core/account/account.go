@@ -0,0 +1,186 @@
+package account
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/job_satisfaction"
+	"career-log-be/models/note/chat"
+	"career-log-be/models/usage"
+	"career-log-be/models/user"
+	"career-log-be/services/auth/core/throttle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GracePeriod는 탈퇴 요청 후 영구 삭제까지의 복구 가능 기간을 반환합니다
+func GracePeriod() time.Duration {
+	days, _ := strconv.Atoi(os.Getenv("ACCOUNT_DELETION_GRACE_DAYS"))
+	if days <= 0 {
+		days = 14 // 기본값 14일
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// ScheduleDeletion은 계정을 탈퇴 상태로 바꾸고 영구 삭제 예정 시각을 반환합니다.
+// 계정은 즉시 soft delete 되어 로그인할 수 없고, 모든 세션과 개인 액세스 토큰이 폐기됩니다.
+func ScheduleDeletion(db *gorm.DB, target *user.User) (time.Time, error) {
+	now := time.Now()
+	purgeAfter := now.Add(GracePeriod())
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return time.Time{}, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	if err := tx.Model(&user.User{}).Where("id = ?", target.ID).Updates(map[string]interface{}{
+		"purge_after": purgeAfter,
+		"deleted_at":  now,
+	}).Error; err != nil {
+		tx.Rollback()
+		return time.Time{}, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to schedule account deletion", err)
+	}
+
+	if err := tx.Model(&user.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", target.ID).
+		Update("revoked_at", now).Error; err != nil {
+		tx.Rollback()
+		return time.Time{}, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke sessions", err)
+	}
+
+	if err := tx.Model(&user.PersonalAccessToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", target.ID).
+		Update("revoked_at", now).Error; err != nil {
+		tx.Rollback()
+		return time.Time{}, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to revoke personal access tokens", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return time.Time{}, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit account deletion", err)
+	}
+
+	return purgeAfter, nil
+}
+
+// Restore는 영구 삭제 전인 탈퇴 계정을 복구합니다. 반드시 트랜잭션 안에서 호출해야 합니다
+func Restore(tx *gorm.DB, userID string) error {
+	result := tx.Unscoped().Model(&user.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL AND purge_after > ?", userID, time.Now()).
+		Updates(map[string]interface{}{
+			"purge_after": nil,
+			"deleted_at":  nil,
+		})
+	if result.Error != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to restore account", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "No restorable account found")
+	}
+	return nil
+}
+
+// PurgeExpired는 복구 기간이 지난 탈퇴 계정을 모두 영구 삭제합니다. 계정 하나의 실패는 다른 계정에 영향을 주지 않습니다
+func PurgeExpired(db *gorm.DB) (int, error) {
+	var userIDs []string
+	if err := db.Unscoped().Model(&user.User{}).
+		Where("deleted_at IS NOT NULL AND purge_after <= ?", time.Now()).
+		Pluck("id", &userIDs).Error; err != nil {
+		return 0, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query accounts to purge", err)
+	}
+
+	purged := 0
+	for _, userID := range userIDs {
+		if _, err := Purge(db, userID); err != nil {
+			log.Printf("Failed to purge account %s: %v", userID, err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// Purge는 사용자가 소유한 모든 데이터를 하나의 트랜잭션에서 영구 삭제하고 삭제 기록(receipt)을 남깁니다
+func Purge(db *gorm.DB, userID string) (*user.AccountErasureReceipt, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to begin transaction", tx.Error)
+	}
+
+	var target user.User
+	if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", userID).First(&target).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Account is not scheduled for deletion")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query account", err)
+	}
+
+	// 삭제 순서: 세션에 종속된 리프레시 토큰 → 사용자 소유 데이터 → 사용자
+	steps := []struct {
+		table string
+		query *gorm.DB
+		model interface{}
+	}{
+		{"user_refresh_tokens", tx.Where("session_id IN (?)", tx.Model(&user.UserSession{}).Select("id").Where("user_id = ?", userID)), &user.UserRefreshToken{}},
+		{"user_sessions", tx.Where("user_id = ?", userID), &user.UserSession{}},
+		{"user_action_tokens", tx.Where("user_id = ?", userID), &user.UserActionToken{}},
+		{"personal_access_tokens", tx.Where("user_id = ?", userID), &user.PersonalAccessToken{}},
+		{"user_mfas", tx.Where("user_id = ?", userID), &user.UserMFA{}},
+		{"user_recovery_codes", tx.Where("user_id = ?", userID), &user.UserRecoveryCode{}},
+		{"user_identities", tx.Where("user_id = ?", userID), &user.UserIdentity{}},
+		{"login_throttles", tx.Where("key = ?", throttle.AccountKey(target.Email)), &user.LoginThrottle{}},
+		{"failed_login_audits", tx.Where("user_id = ? OR email = ?", userID, target.Email), &user.FailedLoginAudit{}},
+		{"user_profiles", tx.Where("id = ?", userID), &user.UserProfile{}},
+		{"user_job_satisfaction_importances", tx.Where("user_id = ?", userID), &job_satisfaction.UserJobSatisfactionImportance{}},
+		{"user_job_satisfactions", tx.Where("user_id = ?", userID), &job_satisfaction.UserJobSatisfaction{}},
+		{"job_satisfaction_update_events", tx.Where("user_id = ?", userID), &job_satisfaction.JobSatisfactionUpdateEvent{}},
+		{"job_satisfaction_outboxes", tx.Where("user_id = ?", userID), &job_satisfaction.JobSatisfactionOutbox{}},
+		{"chat_analysis_attempts", tx.Where("user_id = ?", userID), &chat.ChatAnalysisAttempt{}},
+		{"chat_sets", tx.Where("user_id = ?", userID), &chat.ChatSet{}},
+		{"llm_usages", tx.Where("user_id = ?", userID), &usage.LLMUsage{}},
+		{"llm_quota_reservations", tx.Where("user_id = ?", userID), &usage.LLMQuotaReservation{}},
+		{"user_data_export_chunks", tx.Where("export_id IN (?)", tx.Model(&user.UserDataExport{}).Select("id").Where("user_id = ?", userID)), &user.UserDataExportChunk{}},
+		{"user_data_exports", tx.Where("user_id = ?", userID), &user.UserDataExport{}},
+		{"users", tx.Where("id = ?", userID), &user.User{}},
+	}
+
+	deletedRows := make(map[string]int64, len(steps))
+	for _, step := range steps {
+		result := step.query.Unscoped().Delete(step.model)
+		if result.Error != nil {
+			tx.Rollback()
+			return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, fmt.Sprintf("Failed to purge %s", step.table), result.Error)
+		}
+		deletedRows[step.table] = result.RowsAffected
+	}
+
+	encoded, err := json.Marshal(deletedRows)
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.NewInternalError(errors.ErrorCodeInternalError, "Failed to encode erasure receipt", err)
+	}
+
+	receipt := &user.AccountErasureReceipt{
+		UserID:      userID,
+		RequestedAt: target.DeletedAt.Time,
+		PurgedAt:    time.Now(),
+		DeletedRows: string(encoded),
+	}
+	if err := tx.Create(receipt).Error; err != nil {
+		tx.Rollback()
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to store erasure receipt", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to commit account purge", err)
+	}
+
+	log.Printf("Account erasure receipt %s: user=%s requested_at=%s purged_at=%s deleted_rows=%s",
+		receipt.ID, receipt.UserID, receipt.RequestedAt.Format(time.RFC3339), receipt.PurgedAt.Format(time.RFC3339), receipt.DeletedRows)
+
+	return receipt, nil
+}
@@ -0,0 +1,193 @@
+package export
+
+import (
+	"archive/zip"
+	"career-log-be/models/job_satisfaction"
+	"career-log-be/models/note/chat"
+	"career-log-be/models/note/chat/enums"
+	"career-log-be/models/user"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// writeArchive는 사용자 데이터를 ZIP 형식으로 w에 씁니다.
+// 이벤트와 대화는 한 건씩 읽어 바로 압축 스트림에 쓰므로 원본 레코드를 한꺼번에 메모리에 올리지 않습니다.
+func writeArchive(db *gorm.DB, userID string, w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	if err := writeReadme(archive); err != nil {
+		return err
+	}
+	if err := writeSingle(db, archive, "profile.json", &user.UserProfile{}, "id = ?", userID); err != nil {
+		return err
+	}
+	if err := writeSingle(db, archive, "job_satisfaction/importance.json", &job_satisfaction.UserJobSatisfactionImportance{}, "user_id = ?", userID); err != nil {
+		return err
+	}
+	if err := writeSingle(db, archive, "job_satisfaction/current.json", &job_satisfaction.UserJobSatisfaction{}, "user_id = ?", userID); err != nil {
+		return err
+	}
+	if err := writeEvents(db, archive, userID); err != nil {
+		return err
+	}
+	if err := writeChats(db, archive, userID); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to finalize archive: %v", err)
+	}
+	return nil
+}
+
+func writeReadme(archive *zip.Writer) error {
+	w, err := archive.Create("README.md")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, `# Career Log 데이터 내보내기
+
+생성 시각: %s
+
+- profile.json: 프로필
+- job_satisfaction/importance.json: 직무 만족도 요소별 중요도
+- job_satisfaction/current.json: 현재 직무 만족도
+- job_satisfaction/events.json: 직무 만족도 변경 이력 전체
+- chats/<날짜>_<ID>.json, .md: 대화 기록 (JSON, 읽기용 Markdown)
+`, time.Now().UTC().Format(time.RFC3339))
+	return err
+}
+
+// writeSingle은 단일 레코드를 JSON 파일로 씁니다. 레코드가 없으면 null을 씁니다
+func writeSingle(db *gorm.DB, archive *zip.Writer, name string, dest interface{}, query string, args ...interface{}) error {
+	var value interface{} = dest
+	if err := db.Where(query, args...).First(dest).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return fmt.Errorf("failed to query %s: %v", name, err)
+		}
+		value = nil
+	}
+
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, value)
+}
+
+// writeEvents는 직무 만족도 변경 이력을 시간순 JSON 배열로 씁니다
+func writeEvents(db *gorm.DB, archive *zip.Writer, userID string) error {
+	w, err := archive.Create("job_satisfaction/events.json")
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.Model(&job_satisfaction.JobSatisfactionUpdateEvent{}).
+		Where("user_id = ?", userID).
+		Order("created_at asc").
+		Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query events: %v", err)
+	}
+	defer rows.Close()
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	first := true
+	for rows.Next() {
+		var event job_satisfaction.JobSatisfactionUpdateEvent
+		if err := db.ScanRows(rows, &event); err != nil {
+			return fmt.Errorf("failed to scan event: %v", err)
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := writeJSON(w, event); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read events: %v", err)
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// writeChats는 대화 하나마다 JSON과 Markdown 파일을 씁니다
+func writeChats(db *gorm.DB, archive *zip.Writer, userID string) error {
+	rows, err := db.Model(&chat.ChatSet{}).
+		Where("user_id = ?", userID).
+		Order("created_at asc").
+		Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query chats: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chatSet chat.ChatSet
+		if err := db.ScanRows(rows, &chatSet); err != nil {
+			return fmt.Errorf("failed to scan chat: %v", err)
+		}
+
+		base := fmt.Sprintf("chats/%s_%s", chatSet.CreatedAt.Format("2006-01-02"), chatSet.ID)
+
+		w, err := archive.Create(base + ".json")
+		if err != nil {
+			return err
+		}
+		if err := writeJSON(w, chatSet); err != nil {
+			return err
+		}
+
+		w, err = archive.Create(base + ".md")
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, renderChatMarkdown(&chatSet)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// renderChatMarkdown은 대화를 읽기 쉬운 Markdown으로 변환합니다
+func renderChatMarkdown(chatSet *chat.ChatSet) string {
+	var b strings.Builder
+
+	title := chatSet.Title
+	if title == "" {
+		title = "대화"
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "- 작성일: %s\n", chatSet.CreatedAt.Format(time.RFC3339))
+	fmt.Fprintf(&b, "- 메시지 수: %d\n\n", len(chatSet.ChatData.Messages))
+
+	for _, message := range chatSet.ChatData.Messages {
+		speaker := "나"
+		if message.Role == enums.AssistantRole {
+			speaker = "상담사"
+		}
+		fmt.Fprintf(&b, "### %s (%s)\n\n%s\n\n", speaker, message.Timestamp.Format("2006-01-02 15:04"), message.Content)
+	}
+
+	return b.String()
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	encoded, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode json: %v", err)
+	}
+	_, err = w.Write(encoded)
+	return err
+}
@@ -0,0 +1,95 @@
+package export
+
+import (
+	"career-log-be/models/user"
+	"fmt"
+	"io"
+
+	"gorm.io/gorm"
+)
+
+// chunkSize는 내보내기 파일을 나누어 저장하는 조각 하나의 크기입니다
+const chunkSize = 1 << 20
+
+// chunkWriter는 쓰인 데이터를 chunkSize 단위로 나누어 UserDataExportChunk로 저장합니다.
+// 한 번에 조각 하나만 메모리에 두며, 마지막 조각은 Close에서 저장합니다.
+type chunkWriter struct {
+	db       *gorm.DB
+	exportID string
+	seq      int
+	size     int64
+	buffer   []byte
+}
+
+func newChunkWriter(db *gorm.DB, exportID string) *chunkWriter {
+	return &chunkWriter{db: db, exportID: exportID, buffer: make([]byte, 0, chunkSize)}
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buffer[len(w.buffer):chunkSize], p)
+		w.buffer = w.buffer[:len(w.buffer)+n]
+		p = p[n:]
+		written += n
+		if len(w.buffer) == chunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Close는 남은 데이터를 마지막 조각으로 저장합니다
+func (w *chunkWriter) Close() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *chunkWriter) flush() error {
+	chunk := &user.UserDataExportChunk{ExportID: w.exportID, Seq: w.seq, Data: w.buffer}
+	if err := w.db.Create(chunk).Error; err != nil {
+		return fmt.Errorf("failed to save export chunk %d: %v", w.seq, err)
+	}
+	w.seq++
+	w.size += int64(len(w.buffer))
+	w.buffer = make([]byte, 0, chunkSize)
+	return nil
+}
+
+// chunkReader는 저장된 조각을 순서대로 하나씩 조회하여 읽습니다.
+// 파일 크기만큼 읽기 전에 조각이 없으면(읽는 중에 만료되어 삭제된 경우 등) 에러를 반환합니다.
+type chunkReader struct {
+	db        *gorm.DB
+	exportID  string
+	seq       int
+	remaining int64
+	data      []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		if r.remaining <= 0 {
+			return 0, io.EOF
+		}
+
+		var chunk user.UserDataExportChunk
+		result := r.db.Where("export_id = ? AND seq = ?", r.exportID, r.seq).Limit(1).Find(&chunk)
+		if result.Error != nil {
+			return 0, fmt.Errorf("failed to read export chunk %d: %v", r.seq, result.Error)
+		}
+		if result.RowsAffected == 0 || len(chunk.Data) == 0 {
+			return 0, fmt.Errorf("export chunk %d is missing", r.seq)
+		}
+		r.seq++
+		r.data = chunk.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	r.remaining -= int64(n)
+	return n, nil
+}
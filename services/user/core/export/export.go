@@ -0,0 +1,156 @@
+package export
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// staleAfter는 진행 중인 내보내기를 실패로 간주하는 시간입니다 (서버 재시작 등으로 중단된 작업)
+const staleAfter = time.Hour
+
+// TTL은 완료된 내보내기 파일의 보관 기간을 반환합니다
+func TTL() time.Duration {
+	hours, _ := strconv.Atoi(os.Getenv("EXPORT_TTL_HOURS"))
+	if hours <= 0 {
+		hours = 72 // 기본값 3일
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// Request는 새 내보내기 작업을 등록합니다. 이미 진행 중인 작업이 있으면 충돌 에러를 반환합니다
+func Request(db *gorm.DB, userID string) (*user.UserDataExport, error) {
+	var inProgress int64
+	if err := db.Model(&user.UserDataExport{}).
+		Where("user_id = ? AND status IN ?", userID, []enums.ExportStatus{enums.PendingExportStatus, enums.ProcessingExportStatus}).
+		Count(&inProgress).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query exports", err)
+	}
+	if inProgress > 0 {
+		return nil, errors.NewConflictError(errors.ErrorCodeResourceConflict, "An export is already in progress")
+	}
+
+	dataExport := &user.UserDataExport{
+		UserID: userID,
+		Status: enums.PendingExportStatus,
+	}
+	if err := db.Create(dataExport).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to create export", err)
+	}
+	return dataExport, nil
+}
+
+// Find는 사용자의 내보내기 작업을 조회합니다
+func Find(db *gorm.DB, userID, exportID string) (*user.UserDataExport, error) {
+	var dataExport user.UserDataExport
+	if err := db.Where("id = ? AND user_id = ?", exportID, userID).First(&dataExport).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Export not found")
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query export", err)
+	}
+	return &dataExport, nil
+}
+
+// Run은 내보내기 ZIP 파일을 만들고 결과를 기록합니다. 백그라운드 고루틴에서 호출합니다
+func Run(db *gorm.DB, dataExport *user.UserDataExport) {
+	if err := db.Model(dataExport).Update("status", enums.ProcessingExportStatus).Error; err != nil {
+		log.Printf("Failed to start export %s: %v", dataExport.ID, err)
+		return
+	}
+
+	// ZIP 파일은 만드는 동안 조각 단위로 저장하므로 전체 파일을 메모리에 올리지 않습니다
+	writer := newChunkWriter(db, dataExport.ID)
+	err := writeArchive(db, dataExport.UserID, writer)
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		removeChunks(db, dataExport.ID)
+		markFailed(db, dataExport, err)
+		return
+	}
+
+	now := time.Now()
+	if err := db.Model(dataExport).Updates(map[string]interface{}{
+		"status":       enums.ReadyExportStatus,
+		"file_size":    writer.size,
+		"completed_at": now,
+		"expires_at":   now.Add(TTL()),
+	}).Error; err != nil {
+		removeChunks(db, dataExport.ID)
+		markFailed(db, dataExport, err)
+	}
+}
+
+// Open은 완료된 내보내기의 ZIP 파일을 조각 단위로 읽는 Reader를 반환합니다
+func Open(db *gorm.DB, dataExport *user.UserDataExport) (io.Reader, error) {
+	var count int64
+	if err := db.Model(&user.UserDataExportChunk{}).Where("export_id = ?", dataExport.ID).Count(&count).Error; err != nil {
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query export file", err)
+	}
+	if count == 0 {
+		return nil, errors.NewNotFoundError(errors.ErrorCodeResourceNotFound, "Export file is no longer available")
+	}
+	return &chunkReader{db: db, exportID: dataExport.ID, remaining: dataExport.FileSize}, nil
+}
+
+// removeChunks는 실패한 내보내기에서 이미 저장된 조각을 삭제합니다
+func removeChunks(db *gorm.DB, exportID string) {
+	if err := db.Where("export_id = ?", exportID).Delete(&user.UserDataExportChunk{}).Error; err != nil {
+		log.Printf("Failed to remove chunks of export %s: %v", exportID, err)
+	}
+}
+
+func markFailed(db *gorm.DB, dataExport *user.UserDataExport, cause error) {
+	log.Printf("Export %s failed: %v", dataExport.ID, cause)
+	if err := db.Model(dataExport).Updates(map[string]interface{}{
+		"status": enums.FailedExportStatus,
+		"error":  "export could not be generated",
+	}).Error; err != nil {
+		log.Printf("Failed to mark export %s as failed: %v", dataExport.ID, err)
+	}
+}
+
+// Cleanup은 보관 기간이 지난 내보내기 파일을 삭제하고, 중단된 작업을 실패 처리하여 남은 조각을 삭제합니다
+func Cleanup(db *gorm.DB) error {
+	now := time.Now()
+
+	// 파일 삭제와 만료 표시는 함께 반영하므로, 만료된 내보내기에는 파일이 남지 않습니다
+	err := db.Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&user.UserDataExport{}).Select("id").Where("status = ? AND expires_at <= ?", enums.ReadyExportStatus, now)
+		if err := tx.Where("export_id IN (?)", expired).Delete(&user.UserDataExportChunk{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&user.UserDataExport{}).
+			Where("status = ? AND expires_at <= ?", enums.ReadyExportStatus, now).
+			Update("status", enums.ExpiredExportStatus).Error
+	})
+	if err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to expire exports", err)
+	}
+
+	if err := db.Model(&user.UserDataExport{}).
+		Where("status IN ? AND updated_at <= ?", []enums.ExportStatus{enums.PendingExportStatus, enums.ProcessingExportStatus}, now.Add(-staleAfter)).
+		Updates(map[string]interface{}{
+			"status": enums.FailedExportStatus,
+			"error":  "export was interrupted",
+		}).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to fail stale exports", err)
+	}
+
+	// 중단된 작업이 남긴 조각 삭제
+	failed := db.Model(&user.UserDataExport{}).Select("id").Where("status = ?", enums.FailedExportStatus)
+	if err := db.Where("export_id IN (?)", failed).Delete(&user.UserDataExportChunk{}).Error; err != nil {
+		return errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to remove chunks of failed exports", err)
+	}
+
+	return nil
+}
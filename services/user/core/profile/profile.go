@@ -0,0 +1,22 @@
+package profile
+
+import (
+	"career-log-be/errors"
+	"career-log-be/models/user"
+	"career-log-be/utils/timezone"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Location은 사용자 프로필의 시간대를 반환합니다. 프로필이 없으면 기본 시간대를 사용합니다
+func Location(db *gorm.DB, userID string) (*time.Location, error) {
+	var userProfile user.UserProfile
+	if err := db.Select("id", "timezone").Where("id = ?", userID).First(&userProfile).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return timezone.LoadOrDefault(timezone.DefaultName), nil
+		}
+		return nil, errors.NewInternalError(errors.ErrorCodeDatabaseError, "Failed to query user profile", err)
+	}
+	return userProfile.Location(), nil
+}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	user "career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/auth/core/pat"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type CreatePersonalAccessTokenInput struct {
+	Name          string   `json:"name" validate:"required,max=100"`
+	Scopes        []string `json:"scopes" validate:"required,min=1,dive,required"`
+	ExpiresInDays int      `json:"expiresInDays" validate:"omitempty,min=1,max=365"`
+}
+
+type PersonalAccessTokenResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	TokenHint  string     `json:"tokenHint"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
+
+func newPersonalAccessTokenResponse(accessToken *user.PersonalAccessToken) PersonalAccessTokenResponse {
+	return PersonalAccessTokenResponse{
+		ID:         accessToken.ID,
+		Name:       accessToken.Name,
+		TokenHint:  accessToken.TokenHint,
+		Scopes:     accessToken.ScopeList(),
+		ExpiresAt:  accessToken.ExpiresAt,
+		LastUsedAt: accessToken.LastUsedAt,
+		RevokedAt:  accessToken.RevokedAt,
+		CreatedAt:  accessToken.CreatedAt,
+	}
+}
+
+// HandleCreatePersonalAccessToken은 개인 액세스 토큰을 발급합니다. 토큰 원문은 이 응답에서만 확인할 수 있습니다
+func HandleCreatePersonalAccessToken() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		input := new(CreatePersonalAccessTokenInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		// 입력값 검증
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		for _, scope := range input.Scopes {
+			if !enums.TokenScope(scope).IsValid() {
+				return appErrors.NewValidationError(
+					appErrors.ErrorCodeInvalidInput,
+					"Validation failed",
+					"unknown scope: "+scope,
+				)
+			}
+		}
+
+		var expiresAt *time.Time
+		if input.ExpiresInDays > 0 {
+			expiry := time.Now().AddDate(0, 0, input.ExpiresInDays)
+			expiresAt = &expiry
+		}
+
+		accessToken, raw, err := pat.Create(db, userID, input.Name, input.Scopes, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		return response.Created(c, CreatePersonalAccessTokenResponse{
+			PersonalAccessTokenResponse: newPersonalAccessTokenResponse(accessToken),
+			Token:                       raw,
+		})
+	}
+}
@@ -3,7 +3,7 @@ package user
 import (
 	appErrors "career-log-be/errors"
 	user "career-log-be/models/user"
-	"time"
+	"career-log-be/utils/response"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -12,16 +12,11 @@ import (
 
 var validate = validator.New()
 
-type CreateUserProfileInput struct {
-	Name         string `json:"name" validate:"required"`
-	Organization string `json:"organization" validate:"required"`
-}
-
 func HandleCreateUserProfile() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		db := c.Locals("db").(*gorm.DB)
 		userID := c.Locals("userID").(string)
-		input := new(CreateUserProfileInput)
+		input := new(UserProfileInput)
 
 		if err := c.BodyParser(input); err != nil {
 			return appErrors.NewBadRequestError(
@@ -57,13 +52,9 @@ func HandleCreateUserProfile() fiber.Handler {
 		}
 
 		// 새 프로필 생성
-		userProfile := user.UserProfile{
-			ID:           userID,
-			Name:         input.Name,
-			Nickname:     input.Name,
-			Organization: input.Organization,
-			CreatedAt:    time.Now(),
-			UpdatedAt:    time.Now(),
+		userProfile := user.UserProfile{ID: userID}
+		if err := applyUserProfileInput(&userProfile, input); err != nil {
+			return err
 		}
 
 		if err := db.Create(&userProfile).Error; err != nil {
@@ -74,9 +65,6 @@ func HandleCreateUserProfile() fiber.Handler {
 			)
 		}
 
-		return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-			"success": true,
-			"data":    userProfile,
-		})
+		return response.Created(c, userProfile)
 	}
 }
@@ -0,0 +1,145 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	user "career-log-be/models/user"
+	"career-log-be/services/auth/core/mfa"
+	"career-log-be/services/auth/core/verification"
+	"career-log-be/services/user/core/account"
+	"career-log-be/utils/mail"
+	"career-log-be/utils/response"
+	"log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// recentLoginWindow는 비밀번호가 없는 계정이 탈퇴할 때 요구하는 최근 로그인 기준입니다
+const recentLoginWindow = 10 * time.Minute
+
+type DeleteAccountInput struct {
+	Password string `json:"password"`
+	Code     string `json:"code" validate:"omitempty,len=6,numeric"`
+}
+
+type DeleteAccountResponse struct {
+	PurgeAfter time.Time `json:"purgeAfter"`
+}
+
+// HandleDeleteAccount는 본인 확인 후 계정을 탈퇴 처리합니다.
+// 복구 기간이 지나면 스케줄러가 모든 데이터를 영구 삭제합니다.
+func HandleDeleteAccount() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		sender := c.Locals("mail").(mail.Sender)
+		userID := c.Locals("userID").(string)
+		sessionID := c.Locals("sessionID").(string)
+		input := new(DeleteAccountInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		// 입력값 검증
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		var target user.User
+		if err := db.Where("id = ?", userID).First(&target).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return appErrors.NewNotFoundError(
+					appErrors.ErrorCodeResourceNotFound,
+					"User not found",
+				)
+			}
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query database",
+				err,
+			)
+		}
+
+		// 본인 재확인
+		if err := confirmAccountOwner(db, &target, sessionID, input); err != nil {
+			return err
+		}
+
+		purgeAfter, err := account.ScheduleDeletion(db, &target)
+		if err != nil {
+			return err
+		}
+
+		// 탈퇴는 이미 처리되었으므로 메일 발송 실패는 응답에 영향을 주지 않습니다
+		if err := verification.SendAccountRestoreEmail(c.UserContext(), db, sender, &target, purgeAfter); err != nil {
+			log.Printf("Failed to send account restore email: %v", err)
+		}
+
+		return response.Success(c, DeleteAccountResponse{PurgeAfter: purgeAfter})
+	}
+}
+
+// confirmAccountOwner는 비밀번호와 (활성화된 경우) 2단계 인증 코드를 다시 확인합니다.
+// 비밀번호가 없는 외부 로그인 계정은 최근에 로그인한 세션이어야 합니다.
+func confirmAccountOwner(db *gorm.DB, target *user.User, sessionID string, input *DeleteAccountInput) error {
+	if target.HasPassword() {
+		if err := bcrypt.CompareHashAndPassword([]byte(target.Password), []byte(input.Password)); err != nil {
+			return appErrors.NewAuthorizationError(
+				appErrors.ErrorCodeInvalidCredentials,
+				"Invalid password",
+			)
+		}
+	} else {
+		var current user.UserSession
+		if err := db.Select("id", "created_at").Where("id = ?", sessionID).First(&current).Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to query session",
+				err,
+			)
+		}
+		if time.Since(current.CreatedAt) > recentLoginWindow {
+			return appErrors.NewForbiddenError(
+				appErrors.ErrorCodeReauthRequired,
+				"Please sign in again before deleting your account",
+			)
+		}
+	}
+
+	mfaEnabled, err := mfa.IsEnabled(db, target.ID)
+	if err != nil {
+		return err
+	}
+	if !mfaEnabled {
+		return nil
+	}
+
+	if input.Code == "" {
+		return appErrors.NewForbiddenError(
+			appErrors.ErrorCodeMFARequired,
+			"Two-factor authentication code is required",
+		)
+	}
+	verified, err := mfa.Verify(db, target.ID, input.Code)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return appErrors.NewAuthorizationError(
+			appErrors.ErrorCodeInvalidMFACode,
+			"Invalid verification code",
+		)
+	}
+	return nil
+}
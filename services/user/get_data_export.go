@@ -0,0 +1,53 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/models/user/enums"
+	"career-log-be/services/user/core/export"
+	"career-log-be/utils/response"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleGetDataExport는 내보내기가 완료되었으면 ZIP 파일을 내려주고, 아직 진행 중이면 작업 상태를 반환합니다
+func HandleGetDataExport() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		dataExport, err := export.Find(db, userID, c.Params("id"))
+		if err != nil {
+			return err
+		}
+
+		switch dataExport.Status {
+		case enums.PendingExportStatus, enums.ProcessingExportStatus:
+			c.Status(fiber.StatusAccepted)
+			return response.Success(c, dataExport)
+		case enums.FailedExportStatus:
+			return response.Success(c, dataExport)
+		}
+
+		if !dataExport.IsDownloadable(time.Now()) {
+			return appErrors.NewNotFoundError(
+				appErrors.ErrorCodeResourceNotFound,
+				"Export has expired",
+			)
+		}
+
+		archive, err := export.Open(db, dataExport)
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiber.HeaderContentType, "application/zip")
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(
+			`attachment; filename="career-log-export-%s.zip"`,
+			dataExport.CreatedAt.Format("20060102"),
+		))
+		return c.SendStream(archive, int(dataExport.FileSize))
+	}
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleGetUserProfile은 사용자 프로필을 조회합니다
+func HandleGetUserProfile() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		userProfile, err := findUserProfile(db, userID)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, userProfile)
+	}
+}
@@ -0,0 +1,102 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/services/usage/core/ledger"
+	"career-log-be/services/user/core/profile"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// usageDateLayout은 사용량 조회 기간 입력 형식입니다
+const usageDateLayout = "2006-01-02"
+
+type UsageQuery struct {
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type UsageResponse struct {
+	From     string               `json:"from"`
+	To       string               `json:"to"`
+	Timezone string               `json:"timezone"`
+	Total    ledger.Summary       `json:"total"`
+	ByTask   []ledger.GroupUsage  `json:"byTask"`
+	Daily    []ledger.GroupUsage  `json:"daily"`
+	Quotas   []ledger.QuotaStatus `json:"quotas"`
+}
+
+// HandleGetUsage는 사용자의 LLM 토큰 사용량, 예상 비용과 토큰 한도 현황을 반환합니다.
+// 기간을 지정하지 않으면 이번 달 1일부터 오늘까지(사용자 시간대 기준)를 조회합니다.
+func HandleGetUsage() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		query := new(UsageQuery)
+
+		if err := c.QueryParser(query); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid query parameters",
+			)
+		}
+
+		validate := validator.New()
+		if err := validate.Struct(query); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		loc, err := profile.Location(db, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		local := now.In(loc)
+		toDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+		if query.To != "" {
+			toDate, _ = time.ParseInLocation(usageDateLayout, query.To, loc)
+		}
+		fromDate := time.Date(toDate.Year(), toDate.Month(), 1, 0, 0, 0, 0, loc)
+		if query.From != "" {
+			fromDate, _ = time.ParseInLocation(usageDateLayout, query.From, loc)
+		}
+		if fromDate.After(toDate) {
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				"from must not be after to",
+			)
+		}
+
+		total, byTask, daily, err := ledger.UserUsage(db, userID, fromDate, toDate.AddDate(0, 0, 1), loc)
+		if err != nil {
+			return err
+		}
+
+		quota := c.Locals("quota").(*ledger.QuotaLimiter)
+		quotas, err := quota.Statuses(userID, loc, now)
+		if err != nil {
+			return err
+		}
+
+		return response.Success(c, UsageResponse{
+			From:     fromDate.Format(usageDateLayout),
+			To:       toDate.Format(usageDateLayout),
+			Timezone: loc.String(),
+			Total:    total,
+			ByTask:   byTask,
+			Daily:    daily,
+			Quotas:   quotas,
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"career-log-be/services/auth/core/pat"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleListPersonalAccessTokens는 사용자의 개인 액세스 토큰 목록을 반환합니다
+func HandleListPersonalAccessTokens() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		tokens, err := pat.List(db, userID)
+		if err != nil {
+			return err
+		}
+
+		resp := make([]PersonalAccessTokenResponse, 0, len(tokens))
+		for i := range tokens {
+			resp = append(resp, newPersonalAccessTokenResponse(&tokens[i]))
+		}
+
+		return response.Success(c, resp)
+	}
+}
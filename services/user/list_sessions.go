@@ -0,0 +1,49 @@
+package user
+
+import (
+	"career-log-be/services/auth/core/session"
+	"career-log-be/utils/response"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// HandleListSessions는 로그인되어 있는 세션(기기) 목록을 반환합니다
+func HandleListSessions() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		currentSessionID, _ := c.Locals("sessionID").(string)
+
+		sessions, err := session.ListActiveSessions(db, userID)
+		if err != nil {
+			return err
+		}
+
+		resp := make([]SessionResponse, 0, len(sessions))
+		for _, s := range sessions {
+			resp = append(resp, SessionResponse{
+				ID:         s.ID,
+				UserAgent:  s.UserAgent,
+				IPAddress:  s.IPAddress,
+				Current:    s.ID == currentSessionID,
+				CreatedAt:  s.CreatedAt,
+				LastSeenAt: s.LastSeenAt,
+				ExpiresAt:  s.ExpiresAt,
+			})
+		}
+
+		return response.Success(c, resp)
+	}
+}
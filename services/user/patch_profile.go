@@ -0,0 +1,105 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// PatchUserProfileInput은 일부 항목만 수정할 때 사용합니다.
+// 생략한 항목은 유지되고, 선택 항목에 빈 문자열을 보내면 값이 지워집니다.
+type PatchUserProfileInput struct {
+	Name         *string `json:"name" validate:"omitempty,min=1,max=50"`
+	Nickname     *string `json:"nickname" validate:"omitempty,max=50"`
+	Organization *string `json:"organization" validate:"omitempty,min=1,max=100"`
+	JobTitle     *string `json:"job_title" validate:"omitempty,max=100"`
+	Team         *string `json:"team" validate:"omitempty,max=100"`
+	Seniority    *string `json:"seniority"`
+	StartDate    *string `json:"start_date"`
+	Industry     *string `json:"industry" validate:"omitempty,max=100"`
+	Timezone     *string `json:"timezone" validate:"omitempty,max=64"`
+}
+
+// HandlePatchUserProfile은 사용자 프로필의 일부 항목을 수정합니다
+func HandlePatchUserProfile() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		input := new(PatchUserProfileInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		// 입력값 검증
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		userProfile, err := findUserProfile(db, userID)
+		if err != nil {
+			return err
+		}
+
+		if input.Name != nil {
+			userProfile.Name = *input.Name
+		}
+		if input.Nickname != nil {
+			userProfile.Nickname = *input.Nickname
+		}
+		if input.Organization != nil {
+			userProfile.Organization = *input.Organization
+		}
+		if input.JobTitle != nil {
+			userProfile.JobTitle = *input.JobTitle
+		}
+		if input.Team != nil {
+			userProfile.Team = *input.Team
+		}
+		if input.Seniority != nil {
+			seniority, err := parseSeniority(*input.Seniority)
+			if err != nil {
+				return err
+			}
+			userProfile.Seniority = seniority
+		}
+		if input.StartDate != nil {
+			startDate, err := parseStartDate(*input.StartDate)
+			if err != nil {
+				return err
+			}
+			userProfile.StartDate = startDate
+		}
+		if input.Industry != nil {
+			userProfile.Industry = *input.Industry
+		}
+		if input.Timezone != nil {
+			tz, err := parseTimezone(*input.Timezone)
+			if err != nil {
+				return err
+			}
+			userProfile.Timezone = tz
+		}
+
+		if err := db.Save(userProfile).Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to update user profile",
+				err,
+			)
+		}
+
+		return response.Success(c, userProfile)
+	}
+}
@@ -0,0 +1,124 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	user "career-log-be/models/user"
+	"career-log-be/models/user/enums"
+	"career-log-be/utils/timezone"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// profileDateLayout은 입사일 입력 형식입니다
+const profileDateLayout = "2006-01-02"
+
+type UserProfileInput struct {
+	Name         string `json:"name" validate:"required,max=50"`
+	Nickname     string `json:"nickname" validate:"omitempty,max=50"`
+	Organization string `json:"organization" validate:"required,max=100"`
+	JobTitle     string `json:"job_title" validate:"omitempty,max=100"`
+	Team         string `json:"team" validate:"omitempty,max=100"`
+	Seniority    string `json:"seniority"`
+	StartDate    string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	Industry     string `json:"industry" validate:"omitempty,max=100"`
+	Timezone     string `json:"timezone" validate:"omitempty,max=64"`
+}
+
+// findUserProfile은 사용자의 프로필을 조회합니다
+func findUserProfile(db *gorm.DB, userID string) (*user.UserProfile, error) {
+	var userProfile user.UserProfile
+	if err := db.Where("id = ?", userID).First(&userProfile).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, appErrors.NewNotFoundError(
+				appErrors.ErrorCodeResourceNotFound,
+				"User profile not found",
+			)
+		}
+		return nil, appErrors.NewInternalError(
+			appErrors.ErrorCodeDatabaseError,
+			"Failed to query database",
+			err,
+		)
+	}
+	return &userProfile, nil
+}
+
+// applyUserProfileInput은 입력값 전체를 프로필에 반영합니다 (생성, 전체 수정)
+func applyUserProfileInput(userProfile *user.UserProfile, input *UserProfileInput) error {
+	seniority, err := parseSeniority(input.Seniority)
+	if err != nil {
+		return err
+	}
+	startDate, err := parseStartDate(input.StartDate)
+	if err != nil {
+		return err
+	}
+	tz, err := parseTimezone(input.Timezone)
+	if err != nil {
+		return err
+	}
+
+	userProfile.Name = input.Name
+	userProfile.Nickname = input.Nickname
+	userProfile.Organization = input.Organization
+	userProfile.JobTitle = input.JobTitle
+	userProfile.Team = input.Team
+	userProfile.Seniority = seniority
+	userProfile.StartDate = startDate
+	userProfile.Industry = input.Industry
+	userProfile.Timezone = tz
+	return nil
+}
+
+// parseSeniority는 직급 입력값을 검증합니다. 빈 값은 미입력으로 처리합니다
+func parseSeniority(value string) (enums.Seniority, error) {
+	seniority := enums.Seniority(value)
+	if value != "" && !seniority.IsValid() {
+		return "", appErrors.NewValidationError(
+			appErrors.ErrorCodeInvalidInput,
+			"Validation failed",
+			"unknown seniority: "+value,
+		)
+	}
+	return seniority, nil
+}
+
+// parseStartDate는 입사일 입력값을 검증합니다. 빈 값은 미입력으로 처리하며, 미래 날짜는 허용하지 않습니다
+func parseStartDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	startDate, err := time.Parse(profileDateLayout, value)
+	if err != nil {
+		return nil, appErrors.NewValidationError(
+			appErrors.ErrorCodeInvalidFormat,
+			"Validation failed",
+			"start_date must be in YYYY-MM-DD format",
+		)
+	}
+	if startDate.After(time.Now()) {
+		return nil, appErrors.NewValidationError(
+			appErrors.ErrorCodeInvalidInput,
+			"Validation failed",
+			"start_date must not be in the future",
+		)
+	}
+	return &startDate, nil
+}
+
+// parseTimezone은 IANA 시간대 이름을 검증합니다. 빈 값은 기본 시간대로 처리합니다
+func parseTimezone(value string) (string, error) {
+	if value == "" {
+		return timezone.DefaultName, nil
+	}
+	if !timezone.IsValid(value) {
+		return "", appErrors.NewValidationError(
+			appErrors.ErrorCodeInvalidInput,
+			"Validation failed",
+			"unknown timezone: "+value,
+		)
+	}
+	return value, nil
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"career-log-be/services/user/core/export"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleRequestDataExport는 개인 데이터 내보내기(ZIP) 작업을 등록하고 백그라운드에서 생성을 시작합니다
+func HandleRequestDataExport() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		dataExport, err := export.Request(db, userID)
+		if err != nil {
+			return err
+		}
+
+		go export.Run(db, dataExport)
+
+		c.Status(fiber.StatusAccepted)
+		return response.Success(c, dataExport)
+	}
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"career-log-be/services/auth/core/session"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleRevokeOtherSessions는 현재 세션을 제외한 모든 세션을 폐기합니다
+func HandleRevokeOtherSessions() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		currentSessionID := c.Locals("sessionID").(string)
+
+		if err := session.RevokeAllSessions(db, userID, currentSessionID); err != nil {
+			return err
+		}
+
+		return response.NoContent(c)
+	}
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"career-log-be/services/auth/core/pat"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleRevokePersonalAccessToken은 개인 액세스 토큰을 폐기합니다
+func HandleRevokePersonalAccessToken() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		if err := pat.Revoke(db, userID, c.Params("id")); err != nil {
+			return err
+		}
+
+		return response.NoContent(c)
+	}
+}
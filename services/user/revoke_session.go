@@ -0,0 +1,23 @@
+package user
+
+import (
+	"career-log-be/services/auth/core/session"
+	"career-log-be/utils/response"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleRevokeSession은 지정한 세션을 폐기합니다. 현재 세션을 지정하면 로그아웃과 같습니다
+func HandleRevokeSession() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+
+		if err := session.RevokeUserSession(db, userID, c.Params("id")); err != nil {
+			return err
+		}
+
+		return response.NoContent(c)
+	}
+}
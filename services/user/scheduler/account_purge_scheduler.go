@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/services/user/core/account"
+	"log"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type AccountPurgeScheduler struct {
+	scheduler *gocron.Scheduler
+	db        *gorm.DB
+}
+
+// NewAccountPurgeScheduler 새로운 AccountPurgeScheduler 인스턴스를 생성합니다
+func NewAccountPurgeScheduler(db *gorm.DB) *AccountPurgeScheduler {
+	return &AccountPurgeScheduler{
+		scheduler: gocron.NewScheduler(time.UTC),
+		db:        db,
+	}
+}
+
+// Start 스케줄러를 시작합니다
+func (ps *AccountPurgeScheduler) Start() {
+	// 매시간 복구 기간이 지난 탈퇴 계정을 영구 삭제
+	_, err := ps.scheduler.Every(1).Hour().Do(ps.PurgeExpiredAccounts)
+	if err != nil {
+		log.Printf("Failed to schedule account purge: %v", err)
+	}
+
+	ps.scheduler.StartAsync()
+}
+
+// Stop 스케줄러를 중지합니다
+func (ps *AccountPurgeScheduler) Stop() {
+	ps.scheduler.Stop()
+}
+
+// AccountPurgeJobName 탈퇴 계정 영구 삭제 작업의 이름입니다 (작업 잠금 및 실행 기록에 사용)
+const AccountPurgeJobName = "account_purge"
+
+// PurgeExpiredAccounts 복구 기간이 지난 탈퇴 계정의 데이터를 영구 삭제합니다
+func (ps *AccountPurgeScheduler) PurgeExpiredAccounts() {
+	run, err := jobrun.Run(ps.db, AccountPurgeJobName, nil, func(progress *jobrun.Progress) error {
+		purged, err := account.PurgeExpired(ps.db)
+		progress.Add(purged, 0)
+		return err
+	})
+	if err != nil {
+		log.Printf("Skipped or failed to purge expired accounts: %v", err)
+		return
+	}
+	if run.Processed > 0 {
+		log.Printf("Purged %d expired accounts", run.Processed)
+	}
+}
+
+// InitAccountPurgeScheduler Fiber 앱에 스케줄러를 초기화하고 등록하는 함수
+func InitAccountPurgeScheduler(app *fiber.App, db *gorm.DB) error {
+	purgeScheduler := NewAccountPurgeScheduler(db)
+	purgeScheduler.Start()
+
+	// Fiber 앱이 종료될 때 스케줄러도 함께 종료
+	app.Hooks().OnShutdown(func() error {
+		purgeScheduler.Stop()
+		return nil
+	})
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"career-log-be/services/scheduler/core/jobrun"
+	"career-log-be/services/user/core/export"
+	"log"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ExportCleanupScheduler struct {
+	scheduler *gocron.Scheduler
+	db        *gorm.DB
+}
+
+// NewExportCleanupScheduler 새로운 ExportCleanupScheduler 인스턴스를 생성합니다
+func NewExportCleanupScheduler(db *gorm.DB) *ExportCleanupScheduler {
+	return &ExportCleanupScheduler{
+		scheduler: gocron.NewScheduler(time.UTC),
+		db:        db,
+	}
+}
+
+// Start 스케줄러를 시작합니다
+func (es *ExportCleanupScheduler) Start() {
+	// 매시간 만료된 내보내기 파일 정리
+	_, err := es.scheduler.Every(1).Hour().Do(es.CleanupExports)
+	if err != nil {
+		log.Printf("Failed to schedule export cleanup: %v", err)
+	}
+
+	es.scheduler.StartAsync()
+}
+
+// Stop 스케줄러를 중지합니다
+func (es *ExportCleanupScheduler) Stop() {
+	es.scheduler.Stop()
+}
+
+// ExportCleanupJobName 내보내기 파일 정리 작업의 이름입니다 (작업 잠금 및 실행 기록에 사용)
+const ExportCleanupJobName = "export_cleanup"
+
+// CleanupExports 보관 기간이 지난 내보내기 파일을 삭제합니다
+func (es *ExportCleanupScheduler) CleanupExports() {
+	_, err := jobrun.Run(es.db, ExportCleanupJobName, nil, func(progress *jobrun.Progress) error {
+		return export.Cleanup(es.db)
+	})
+	if err != nil {
+		log.Printf("Skipped or failed to clean up exports: %v", err)
+	}
+}
+
+// InitExportCleanupScheduler Fiber 앱에 스케줄러를 초기화하고 등록하는 함수
+func InitExportCleanupScheduler(app *fiber.App, db *gorm.DB) error {
+	cleanupScheduler := NewExportCleanupScheduler(db)
+	cleanupScheduler.Start()
+
+	// Fiber 앱이 종료될 때 스케줄러도 함께 종료
+	app.Hooks().OnShutdown(func() error {
+		cleanupScheduler.Stop()
+		return nil
+	})
+
+	return nil
+}
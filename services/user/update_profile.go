@@ -0,0 +1,55 @@
+package user
+
+import (
+	appErrors "career-log-be/errors"
+	"career-log-be/utils/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// HandleUpdateUserProfile은 사용자 프로필 전체를 교체합니다. 입력하지 않은 선택 항목은 비워집니다
+func HandleUpdateUserProfile() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		db := c.Locals("db").(*gorm.DB)
+		userID := c.Locals("userID").(string)
+		input := new(UserProfileInput)
+
+		if err := c.BodyParser(input); err != nil {
+			return appErrors.NewBadRequestError(
+				appErrors.ErrorCodeInvalidInput,
+				"Invalid request body",
+			)
+		}
+
+		// 입력값 검증
+		if err := validate.Struct(input); err != nil {
+			validationErrors := err.(validator.ValidationErrors)
+			return appErrors.NewValidationError(
+				appErrors.ErrorCodeInvalidInput,
+				"Validation failed",
+				validationErrors.Error(),
+			)
+		}
+
+		userProfile, err := findUserProfile(db, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := applyUserProfileInput(userProfile, input); err != nil {
+			return err
+		}
+
+		if err := db.Save(userProfile).Error; err != nil {
+			return appErrors.NewInternalError(
+				appErrors.ErrorCodeDatabaseError,
+				"Failed to update user profile",
+				err,
+			)
+		}
+
+		return response.Success(c, userProfile)
+	}
+}
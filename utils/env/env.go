@@ -0,0 +1,26 @@
+// Package env reads numeric settings from environment variables with a single set of rules
+package env
+
+import (
+	"os"
+	"strconv"
+)
+
+// Int는 양의 정수 환경 변수를 읽습니다. 없거나 잘못되었거나 0 이하이면 기본값을 반환합니다
+func Int(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// NonNegativeInt는 0을 허용하는 정수 환경 변수를 읽습니다 (0이 "사용하지 않음"을 뜻하는 설정용).
+// 없거나 잘못되었거나 음수이면 기본값을 반환합니다
+func NonNegativeInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -9,43 +9,148 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// SessionValidator는 토큰에 연결된 세션이 아직 유효한지 확인하는 인터페이스입니다
+type SessionValidator interface {
+	IsSessionActive(sessionID string) (bool, error)
+}
+
 type JWTUtils struct {
-	secretKey   []byte
-	expiryHours int
+	secretKey        []byte
+	keyring          *Keyring
+	hmacFallback     bool
+	expiryMinutes    int
+	sessionValidator SessionValidator
 }
 
-func NewJWTUtils() *JWTUtils {
-	expiryHours, _ := strconv.Atoi(os.Getenv("JWT_EXPIRY_HOURS"))
-	if expiryHours == 0 {
-		expiryHours = 24 // 기본값 24시간
+// NewJWTUtils는 환경 변수로부터 JWT 유틸리티를 생성합니다.
+// JWT_KEY_DIR이 설정되어 있으면 비대칭 키(RS256/EdDSA)로 서명하고,
+// 그렇지 않으면 JWT_SECRET 기반 HS256으로 서명합니다.
+func NewJWTUtils() (*JWTUtils, error) {
+	expiryMinutes, _ := strconv.Atoi(os.Getenv("JWT_ACCESS_EXPIRY_MINUTES"))
+	if expiryMinutes == 0 {
+		expiryMinutes = 15 // 기본값 15분
+	}
+
+	// 마이그레이션 기간 동안 HS256 토큰 검증 허용 여부 (기본값 true)
+	hmacFallback := true
+	if value := os.Getenv("JWT_HS256_FALLBACK"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT_HS256_FALLBACK value: %v", err)
+		}
+		hmacFallback = parsed
+	}
+
+	utils := &JWTUtils{
+		secretKey:     []byte(os.Getenv("JWT_SECRET")),
+		hmacFallback:  hmacFallback,
+		expiryMinutes: expiryMinutes,
+	}
+
+	if keyDir := os.Getenv("JWT_KEY_DIR"); keyDir != "" {
+		keyring, err := LoadKeyring(keyDir, os.Getenv("JWT_ACTIVE_KID"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT keyring: %v", err)
+		}
+		utils.keyring = keyring
 	}
 
-	return &JWTUtils{
-		secretKey:   []byte(os.Getenv("JWT_SECRET")),
-		expiryHours: expiryHours,
+	return utils, nil
+}
+
+// SetKeyring은 비대칭 서명에 사용할 키링을 등록합니다
+func (j *JWTUtils) SetKeyring(keyring *Keyring) {
+	j.keyring = keyring
+}
+
+// JWKS는 토큰 검증용 공개키 목록을 반환합니다. 키링이 없으면 빈 목록을 반환합니다
+func (j *JWTUtils) JWKS() JWKSet {
+	if j.keyring == nil {
+		return JWKSet{Keys: []JWK{}}
 	}
+	return j.keyring.JWKS()
+}
+
+// SetSessionValidator는 세션 폐기 여부를 확인할 Validator를 등록합니다
+func (j *JWTUtils) SetSessionValidator(validator SessionValidator) {
+	j.sessionValidator = validator
+}
+
+// AccessTokenTTL은 액세스 토큰의 유효 기간을 반환합니다
+func (j *JWTUtils) AccessTokenTTL() time.Duration {
+	return time.Duration(j.expiryMinutes) * time.Minute
 }
 
+// 토큰 용도 (use 클레임)
+const (
+	tokenUseAccess       = "access"
+	tokenUseMFAChallenge = "mfa_challenge"
+)
+
+// mfaChallengeTTL은 2단계 인증 챌린지 토큰의 유효 기간입니다
+const mfaChallengeTTL = 5 * time.Minute
+
 type UserClaims struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+	ID        string   `json:"id"`
+	Email     string   `json:"email"`
+	SessionID string   `json:"sid"`
+	AMR       []string `json:"amr,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	Use       string   `json:"use,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateToken creates a new JWT token for a user
-func (j *JWTUtils) GenerateToken(userID, email string) (string, error) {
+// HasAMR는 토큰이 주어진 인증 방식을 거쳐 발급되었는지 확인합니다
+func (c *UserClaims) HasAMR(method string) bool {
+	for _, amr := range c.AMR {
+		if amr == method {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenParams는 액세스 토큰에 담길 사용자 정보입니다
+type TokenParams struct {
+	UserID    string
+	Email     string
+	SessionID string
+	AMR       []string
+	Roles     []string
+}
+
+// GenerateToken creates a new short-lived access token bound to a session
+func (j *JWTUtils) GenerateToken(params TokenParams) (string, error) {
 	claims := UserClaims{
-		ID:    userID,
-		Email: email,
+		ID:        params.UserID,
+		Email:     params.Email,
+		SessionID: params.SessionID,
+		AMR:       params.AMR,
+		Roles:     params.Roles,
+		Use:       tokenUseAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(j.expiryHours) * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.AccessTokenTTL())),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(j.secretKey)
+	return j.sign(claims)
+}
+
+// sign은 활성 키가 있으면 kid 헤더와 함께 비대칭 서명하고, 없으면 HS256으로 서명합니다
+func (j *JWTUtils) sign(claims jwt.Claims) (string, error) {
+	var signedToken string
+	var err error
+	if j.keyring != nil {
+		active := j.keyring.Active()
+		token := jwt.NewWithClaims(active.Method, claims)
+		token.Header["kid"] = active.ID
+		signedToken, err = token.SignedString(active.PrivateKey)
+	} else {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		signedToken, err = token.SignedString(j.secretKey)
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %v", err)
 	}
@@ -53,22 +158,102 @@ func (j *JWTUtils) GenerateToken(userID, email string) (string, error) {
 	return signedToken, nil
 }
 
-// ValidateToken validates the JWT token and returns the claims
-func (j *JWTUtils) ValidateToken(tokenString string) (*UserClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// keyFunc는 토큰 헤더의 alg/kid에 맞는 검증 키를 선택합니다
+func (j *JWTUtils) keyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if j.keyring != nil && !j.hmacFallback {
+			return nil, fmt.Errorf("HS256 tokens are no longer accepted")
+		}
+		if len(j.secretKey) == 0 {
+			return nil, fmt.Errorf("HS256 secret is not configured")
 		}
 		return j.secretKey, nil
-	})
+	}
+
+	if j.keyring == nil {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, exists := j.keyring.Lookup(kid)
+	if !exists {
+		return nil, fmt.Errorf("unknown key id: %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("signing method %v does not match key %q", token.Header["alg"], kid)
+	}
+
+	return key.PublicKey, nil
+}
+
+// ValidateToken validates the JWT token and returns the claims.
+// Tokens whose session has been revoked are rejected.
+func (j *JWTUtils) ValidateToken(tokenString string) (*UserClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, j.keyFunc)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %v", err)
+	}
+
+	claims, ok := token.Claims.(*UserClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	if claims.Use != "" && claims.Use != tokenUseAccess {
+		return nil, fmt.Errorf("token is not an access token")
+	}
+
+	if j.sessionValidator != nil {
+		if claims.SessionID == "" {
+			return nil, fmt.Errorf("token is not bound to a session")
+		}
+		active, err := j.sessionValidator.IsSessionActive(claims.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate session: %v", err)
+		}
+		if !active {
+			return nil, fmt.Errorf("session has been revoked")
+		}
+	}
+
+	return claims, nil
+}
+
+// MFAChallengeTTL은 2단계 인증 챌린지 토큰의 유효 기간을 반환합니다
+func (j *JWTUtils) MFAChallengeTTL() time.Duration {
+	return mfaChallengeTTL
+}
+
+// GenerateMFAChallengeToken은 첫 번째 인증(비밀번호, 외부 로그인) 후 2단계 인증을 기다리는 짧은 수명의 토큰을 생성합니다.
+// amr에는 이미 거친 첫 번째 인증 방식을 담습니다.
+func (j *JWTUtils) GenerateMFAChallengeToken(userID, email string, amr []string) (string, error) {
+	claims := UserClaims{
+		ID:    userID,
+		Email: email,
+		AMR:   amr,
+		Use:   tokenUseMFAChallenge,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(mfaChallengeTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	return j.sign(claims)
+}
 
+// ValidateMFAChallengeToken은 2단계 인증 챌린지 토큰을 검증합니다
+func (j *JWTUtils) ValidateMFAChallengeToken(tokenString string) (*UserClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, j.keyFunc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %v", err)
 	}
 
-	if claims, ok := token.Claims.(*UserClaims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*UserClaims)
+	if !ok || !token.Valid || claims.Use != tokenUseMFAChallenge {
+		return nil, fmt.Errorf("invalid MFA challenge token")
 	}
 
-	return nil, fmt.Errorf("invalid token")
+	return claims, nil
 }
@@ -0,0 +1,198 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningKey는 kid로 식별되는 비대칭 서명 키입니다.
+// PrivateKey가 nil이면 검증 전용(퇴역한 키)입니다.
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// Keyring은 현재 서명에 사용하는 활성 키와, 검증에만 사용하는 퇴역 키들을 보관합니다
+type Keyring struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+}
+
+// JWK는 JSON Web Key 형식의 공개키입니다
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet은 /.well-known/jwks.json 응답 형식입니다
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadKeyring은 디렉토리의 PEM 파일들을 읽어 키링을 구성합니다.
+// 파일명(확장자 제외)이 kid가 되며, activeKeyID에 해당하는 키로 새 토큰을 서명합니다.
+func LoadKeyring(dir, activeKeyID string) (*Keyring, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key directory: %v", err)
+	}
+	sort.Strings(files)
+
+	keyring := &Keyring{keys: map[string]*SigningKey{}}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %s: %v", kid, err)
+		}
+
+		key, err := ParseSigningKey(kid, data)
+		if err != nil {
+			return nil, err
+		}
+		keyring.keys[kid] = key
+	}
+
+	if err := keyring.SetActive(activeKeyID); err != nil {
+		return nil, err
+	}
+
+	return keyring, nil
+}
+
+// NewKeyring은 주어진 키들로 키링을 생성합니다
+func NewKeyring(activeKeyID string, keys ...*SigningKey) (*Keyring, error) {
+	keyring := &Keyring{keys: map[string]*SigningKey{}}
+	for _, key := range keys {
+		keyring.keys[key.ID] = key
+	}
+
+	if err := keyring.SetActive(activeKeyID); err != nil {
+		return nil, err
+	}
+
+	return keyring, nil
+}
+
+// SetActive는 서명에 사용할 활성 키를 변경합니다. 이전 활성 키는 검증용으로 남습니다
+func (k *Keyring) SetActive(keyID string) error {
+	key, exists := k.keys[keyID]
+	if !exists {
+		return fmt.Errorf("active key %q not found in keyring", keyID)
+	}
+	if key.PrivateKey == nil {
+		return fmt.Errorf("active key %q has no private key", keyID)
+	}
+
+	k.active = key
+	return nil
+}
+
+// Active는 현재 서명에 사용하는 키를 반환합니다
+func (k *Keyring) Active() *SigningKey {
+	return k.active
+}
+
+// Lookup은 kid로 검증 키를 찾습니다
+func (k *Keyring) Lookup(keyID string) (*SigningKey, bool) {
+	key, exists := k.keys[keyID]
+	return key, exists
+}
+
+// JWKS는 키링의 모든 공개키를 JWK Set으로 반환합니다
+func (k *Keyring) JWKS() JWKSet {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	set := JWKSet{Keys: []JWK{}}
+	for _, id := range ids {
+		key := k.keys[id]
+		jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+
+		switch pub := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
+
+// ParseSigningKey는 PEM 인코딩된 RSA/Ed25519 개인키 또는 공개키를 파싱합니다
+func ParseSigningKey(keyID string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s: invalid PEM data", keyID)
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("key %s: unsupported PEM block type %q", keyID, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %s: failed to parse key: %v", keyID, err)
+	}
+
+	key := &SigningKey{ID: keyID}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method = jwt.SigningMethodRS256
+		key.PrivateKey = k
+		key.PublicKey = &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+		key.PublicKey = k
+	case ed25519.PrivateKey:
+		key.Method = jwt.SigningMethodEdDSA
+		key.PrivateKey = k
+		key.PublicKey = k.Public()
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+		key.PublicKey = k
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %T", keyID, parsed)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,281 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	anthropicDefaultBaseURL   = "https://api.anthropic.com"
+	anthropicVersion          = "2023-06-01"
+	anthropicDefaultMaxTokens = 1024
+)
+
+// AnthropicProvider는 Anthropic Messages API를 사용하는 제공자입니다
+type AnthropicProvider struct {
+	apiKey     string
+	baseURL    string
+	models     Models
+	httpClient *http.Client
+}
+
+// NewAnthropicProvider는 새로운 AnthropicProvider를 생성합니다. baseURL이 비어 있으면 Anthropic API를 사용합니다
+func NewAnthropicProvider(apiKey, baseURL string, models Models) *AnthropicProvider {
+	if baseURL == "" {
+		baseURL = anthropicDefaultBaseURL
+	}
+
+	return &AnthropicProvider{
+		apiKey:     apiKey,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		models:     models,
+		httpClient: &http.Client{},
+	}
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicTool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"input_schema"`
+}
+
+type anthropicToolChoice struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type anthropicRequest struct {
+	Model      string               `json:"model"`
+	MaxTokens  int                  `json:"max_tokens"`
+	System     string               `json:"system,omitempty"`
+	Messages   []anthropicMessage   `json:"messages"`
+	Stream     bool                 `json:"stream,omitempty"`
+	Tools      []anthropicTool      `json:"tools,omitempty"`
+	ToolChoice *anthropicToolChoice `json:"tool_choice,omitempty"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type anthropicContentBlock struct {
+	Type  string          `json:"type"`
+	Text  string          `json:"text"`
+	Name  string          `json:"name"`
+	Input json.RawMessage `json:"input"`
+}
+
+type anthropicResponse struct {
+	Model   string                  `json:"model"`
+	Content []anthropicContentBlock `json:"content"`
+	Usage   anthropicUsage          `json:"usage"`
+}
+
+type anthropicStreamEvent struct {
+	Type    string             `json:"type"`
+	Message *anthropicResponse `json:"message"`
+	Delta   struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Usage *anthropicUsage `json:"usage"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Name은 제공자 이름을 반환합니다
+func (p *AnthropicProvider) Name() string {
+	return "anthropic"
+}
+
+// Model은 작업에 사용하는 모델 이름을 반환합니다
+func (p *AnthropicProvider) Model(task Task) string {
+	return p.models.For(task)
+}
+
+// Complete는 응답 전체를 한 번에 받습니다
+func (p *AnthropicProvider) Complete(ctx context.Context, request Request) (*Response, error) {
+	resp, err := p.send(ctx, p.messagesRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result anthropicResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	for _, block := range result.Content {
+		if block.Type == "text" {
+			content.WriteString(block.Text)
+		}
+	}
+
+	return &Response{Content: content.String(), Model: result.Model, Usage: result.Usage.toUsage()}, nil
+}
+
+// CompleteStructured는 schema를 입력 스키마로 하는 도구 호출을 강제하여 JSON 응답을 받습니다
+func (p *AnthropicProvider) CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error) {
+	messagesRequest := p.messagesRequest(request)
+	messagesRequest.Tools = []anthropicTool{{Name: schema.Name, Description: schema.Description, InputSchema: schema.Definition}}
+	messagesRequest.ToolChoice = &anthropicToolChoice{Type: "tool", Name: schema.Name}
+
+	resp, err := p.send(ctx, messagesRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result anthropicResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	for _, block := range result.Content {
+		if block.Type == "tool_use" && block.Name == schema.Name {
+			return &Response{Content: string(block.Input), Model: result.Model, Usage: result.Usage.toUsage()}, nil
+		}
+	}
+
+	return nil, errors.New("no structured output in response")
+}
+
+// Stream은 서버 전송 이벤트로 받은 응답을 조각 단위로 onChunk에 전달합니다
+func (p *AnthropicProvider) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error) {
+	messagesRequest := p.messagesRequest(request)
+	messagesRequest.Stream = true
+
+	resp, err := p.send(ctx, messagesRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := &Response{Model: messagesRequest.Model}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+			return nil, err
+		}
+
+		switch event.Type {
+		case "message_start":
+			if event.Message != nil {
+				response.Model = event.Message.Model
+				response.Usage.InputTokens = event.Message.Usage.InputTokens
+			}
+		case "content_block_delta":
+			if event.Delta.Type == "text_delta" && event.Delta.Text != "" {
+				response.Content += event.Delta.Text
+				if err := onChunk(event.Delta.Text); err != nil {
+					return nil, err
+				}
+			}
+		case "message_delta":
+			if event.Usage != nil {
+				response.Usage.OutputTokens = event.Usage.OutputTokens
+			}
+		case "message_stop":
+			return response, nil
+		case "error":
+			if event.Error != nil && event.Error.Type == "overloaded_error" {
+				return nil, &StatusError{Provider: "anthropic", StatusCode: 529, Err: errors.New(event.Error.Message)}
+			}
+			if event.Error != nil {
+				return nil, fmt.Errorf("anthropic stream error: %s: %s", event.Error.Type, event.Error.Message)
+			}
+			return nil, errors.New("anthropic stream error")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.ErrUnexpectedEOF
+}
+
+func (p *AnthropicProvider) send(ctx context.Context, messagesRequest anthropicRequest) (*http.Response, error) {
+	body, err := json.Marshal(messagesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", p.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &StatusError{
+			Provider:   "anthropic",
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Err:        errors.New(strings.TrimSpace(string(message))),
+		}
+	}
+
+	return resp, nil
+}
+
+// messagesRequest는 시스템 메시지를 system 필드로 분리하여 Messages API 요청을 만듭니다
+func (p *AnthropicProvider) messagesRequest(request Request) anthropicRequest {
+	maxTokens := request.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = anthropicDefaultMaxTokens
+	}
+
+	var system []string
+	messages := make([]anthropicMessage, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		if message.Role == SystemRole {
+			system = append(system, message.Content)
+			continue
+		}
+		messages = append(messages, anthropicMessage{Role: string(message.Role), Content: message.Content})
+	}
+
+	return anthropicRequest{
+		Model:     p.models.For(request.Task),
+		MaxTokens: maxTokens,
+		System:    strings.Join(system, "\n\n"),
+		Messages:  messages,
+	}
+}
+
+func (u anthropicUsage) toUsage() Usage {
+	return Usage{InputTokens: u.InputTokens, OutputTokens: u.OutputTokens}
+}
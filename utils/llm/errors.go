@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen은 제공자 장애로 회로 차단기가 열려 호출하지 않았을 때의 에러입니다
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// StatusError는 제공자 API가 실패 상태 코드로 응답했을 때의 에러입니다
+type StatusError struct {
+	Provider   string
+	StatusCode int
+	RetryAfter time.Duration // Retry-After 헤더 값 (없으면 0)
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s API error: status %d: %v", e.Provider, e.StatusCode, e.Err)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable은 잠시 후 다시 요청하면 성공할 수 있는 상태 코드인지 반환합니다 (요청 한도 초과, 서버 오류)
+func (e *StatusError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, 529: // 529: Anthropic 과부하
+		return true
+	}
+	return e.StatusCode >= http.StatusInternalServerError
+}
+
+// UnavailableError는 재시도 후에도 제공자에게서 응답을 받지 못했을 때의 에러입니다
+type UnavailableError struct {
+	Provider   string
+	RetryAfter time.Duration // 다시 시도해도 되는 시점까지 남은 시간 (알 수 없으면 0)
+	Err        error
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s is unavailable: %v", e.Provider, e.Err)
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// parseRetryAfter는 Retry-After 헤더(초 또는 HTTP 날짜)를 해석합니다
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+type retryAfterKey struct{}
+
+// retryAfterRecorder는 HTTP 응답의 Retry-After 헤더를 요청 컨텍스트에 기록합니다.
+// 에러에 헤더를 담아주지 않는 클라이언트 라이브러리(go-openai)에서 Retry-After를 읽는 데 사용합니다.
+type retryAfterRecorder struct {
+	mu    sync.Mutex
+	value time.Duration
+}
+
+func withRetryAfterRecorder(ctx context.Context) (context.Context, *retryAfterRecorder) {
+	recorder := &retryAfterRecorder{}
+	return context.WithValue(ctx, retryAfterKey{}, recorder), recorder
+}
+
+func (r *retryAfterRecorder) get() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.value
+}
+
+// retryAfterDoer는 응답의 Retry-After 헤더를 요청 컨텍스트의 retryAfterRecorder에 남기는 HTTP 클라이언트입니다
+type retryAfterDoer struct {
+	client *http.Client
+}
+
+func (d retryAfterDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if recorder, ok := req.Context().Value(retryAfterKey{}).(*retryAfterRecorder); ok {
+		recorder.mu.Lock()
+		recorder.value = parseRetryAfter(resp.Header.Get("Retry-After"))
+		recorder.mu.Unlock()
+	}
+	return resp, nil
+}
@@ -0,0 +1,169 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeReply는 FakeProvider가 돌려줄 응답 하나입니다
+type FakeReply struct {
+	Content string        // 응답 내용 (Complete, CompleteStructured)
+	Chunks  []string      // 스트리밍 조각, 비어 있으면 Content를 단어 단위로 나눠 전송
+	Err     error         // 응답 대신 반환할 에러 (스트리밍이면 조각을 모두 보낸 뒤 반환)
+	Latency time.Duration // 응답 전 대기 시간 (ctx가 취소되면 즉시 중단)
+}
+
+// FakeMalformedJSON은 구조화된 출력을 따르지 않는 응답입니다 (코드 펜스와 잘린 JSON)
+var FakeMalformedJSON = FakeReply{Content: "```json\n{\"workload\": 3, \"compensation\":"}
+
+// FakeProvider는 외부 API를 호출하지 않고 미리 정한 응답을 돌려주는 제공자입니다.
+// 작업별로 Script로 등록한 응답을 순서대로 사용하고, 모두 쓰면 기본 응답을 돌려줍니다.
+// 로컬 개발 및 테스트용입니다.
+type FakeProvider struct {
+	mu       sync.Mutex
+	scripts  map[Task][]FakeReply
+	defaults map[Task]FakeReply
+	requests []Request
+}
+
+// NewFakeProvider는 새로운 FakeProvider를 생성합니다
+func NewFakeProvider() *FakeProvider {
+	p := &FakeProvider{}
+	p.Reset()
+	return p
+}
+
+// Reset은 등록된 응답과 요청 기록을 지우고 기본 응답을 처음 값으로 되돌립니다
+func (p *FakeProvider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.scripts = make(map[Task][]FakeReply)
+	p.defaults = map[Task]FakeReply{
+		ChatTask:     {Content: "그랬군요. 오늘 어떤 일이 가장 기억에 남으셨나요?"},
+		AnalysisTask: {Content: `{"workload": 5, "compensation": 0, "growth": 3, "workEnvironment": -2, "workRelationships": 4, "workValues": 0}`},
+	}
+	p.requests = nil
+}
+
+// Script는 작업의 다음 응답들을 순서대로 등록합니다
+func (p *FakeProvider) Script(task Task, replies ...FakeReply) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.scripts[task] = append(p.scripts[task], replies...)
+}
+
+// SetDefault는 등록된 응답이 없을 때 돌려줄 작업의 기본 응답을 바꿉니다
+func (p *FakeProvider) SetDefault(task Task, reply FakeReply) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.defaults[task] = reply
+}
+
+// Requests는 지금까지 받은 요청 목록을 반환합니다
+func (p *FakeProvider) Requests() []Request {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	requests := make([]Request, len(p.requests))
+	copy(requests, p.requests)
+	return requests
+}
+
+// Name은 제공자 이름을 반환합니다
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+// Model은 작업에 사용하는 모델 이름을 반환합니다
+func (p *FakeProvider) Model(task Task) string {
+	return "fake-" + string(task)
+}
+
+// Complete는 다음 응답을 돌려줍니다
+func (p *FakeProvider) Complete(ctx context.Context, request Request) (*Response, error) {
+	reply, err := p.next(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return p.response(request, reply.Content), nil
+}
+
+// CompleteStructured는 다음 응답을 그대로 돌려줍니다. 스키마는 검사하지 않습니다
+func (p *FakeProvider) CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error) {
+	return p.Complete(ctx, request)
+}
+
+// Stream은 다음 응답을 조각 단위로 onChunk에 전달합니다
+func (p *FakeProvider) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error) {
+	reply, err := p.next(ctx, request)
+	if err != nil && len(reply.Chunks) == 0 {
+		return nil, err
+	}
+
+	chunks := reply.Chunks
+	if len(chunks) == 0 {
+		chunks = strings.SplitAfter(reply.Content, " ")
+	}
+
+	var content strings.Builder
+	for _, chunk := range chunks {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if chunk == "" {
+			continue
+		}
+		content.WriteString(chunk)
+		if err := onChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.response(request, content.String()), nil
+}
+
+// next는 요청을 기록하고, 지연 시간만큼 기다린 뒤 작업의 다음 응답을 꺼냅니다.
+// 응답에 에러가 있으면 응답과 함께 에러를 반환합니다.
+func (p *FakeProvider) next(ctx context.Context, request Request) (FakeReply, error) {
+	p.mu.Lock()
+	p.requests = append(p.requests, request)
+	reply := p.defaults[request.Task]
+	if script := p.scripts[request.Task]; len(script) > 0 {
+		reply = script[0]
+		p.scripts[request.Task] = script[1:]
+	}
+	p.mu.Unlock()
+
+	if reply.Latency > 0 {
+		timer := time.NewTimer(reply.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return FakeReply{}, ctx.Err()
+		}
+	}
+
+	return reply, reply.Err
+}
+
+func (p *FakeProvider) response(request Request, content string) *Response {
+	var input int
+	for _, message := range request.Messages {
+		input += len(strings.Fields(message.Content))
+	}
+
+	return &Response{
+		Content: content,
+		Model:   p.Model(request.Task),
+		Usage:   Usage{InputTokens: input, OutputTokens: len(strings.Fields(content))},
+	}
+}
@@ -0,0 +1,149 @@
+// Package llm provides pluggable LLM providers
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrMissingAPIKey는 API 키가 없을 때 발생하는 에러입니다
+var ErrMissingAPIKey = errors.New("missing API key")
+
+// Role은 대화 메시지의 역할입니다
+type Role string
+
+const (
+	SystemRole    Role = "system"
+	UserRole      Role = "user"
+	AssistantRole Role = "assistant"
+)
+
+// Message는 대화 메시지 하나입니다
+type Message struct {
+	Role    Role
+	Content string
+}
+
+// Task는 모델을 선택하는 기준이 되는 작업 종류입니다
+type Task string
+
+const (
+	// ChatTask는 상담 대화입니다
+	ChatTask Task = "chat"
+	// AnalysisTask는 대화 분석입니다
+	AnalysisTask Task = "analysis"
+)
+
+// Request는 모델 호출 요청입니다. 모델은 Task에 따라 제공자 설정에서 선택됩니다
+type Request struct {
+	Task      Task
+	Messages  []Message
+	MaxTokens int // 0이면 제공자 기본값
+}
+
+// Schema는 구조화된 출력에 사용할 JSON 스키마입니다
+type Schema struct {
+	Name        string
+	Description string
+	Definition  json.RawMessage
+}
+
+// Usage는 요청 한 번의 토큰 사용량입니다
+type Usage struct {
+	InputTokens  int
+	OutputTokens int
+	Estimated    bool // 제공자가 알려주지 않아 어림한 값 (중간에 실패한 스트리밍)
+}
+
+// Response는 모델 응답입니다
+type Response struct {
+	Content string
+	Model   string
+	Usage   Usage
+}
+
+// LLMProvider는 LLM 제공자 구현체의 인터페이스입니다
+type LLMProvider interface {
+	// Name은 제공자 이름을 반환합니다 (openai, anthropic, ollama)
+	Name() string
+	// Model은 작업에 사용하는 모델 이름을 반환합니다
+	Model(task Task) string
+	// Complete는 응답 전체를 한 번에 받습니다
+	Complete(ctx context.Context, request Request) (*Response, error)
+	// Stream은 응답을 조각 단위로 onChunk에 전달하고, 끝나면 전체 응답을 반환합니다.
+	// onChunk가 에러를 반환하면 스트리밍을 중단하고 그 에러를 반환합니다.
+	Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error)
+	// CompleteStructured는 schema를 따르는 JSON 응답을 받습니다. Content는 JSON 문자열입니다
+	CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error)
+}
+
+// Models는 작업별로 사용할 모델입니다
+type Models struct {
+	Chat     string
+	Analysis string
+}
+
+// For는 작업에 사용할 모델을 반환합니다
+func (m Models) For(task Task) string {
+	if task == AnalysisTask {
+		return m.Analysis
+	}
+	return m.Chat
+}
+
+// NewProviderFromEnv는 LLM_PROVIDER 환경 변수에 따라 LLMProvider를 생성합니다.
+// openai(기본값): OpenAI API, anthropic: Anthropic API, ollama: Ollama 등 OpenAI 호환 서버.
+// fake: 외부 API 없이 정해진 응답을 돌려주는 FakeProvider (로컬 개발 및 테스트용).
+// 작업별 모델은 LLM_CHAT_MODEL, LLM_ANALYSIS_MODEL로 바꿀 수 있습니다.
+// 생성한 제공자는 재시도와 회로 차단기를 적용한 ResilientProvider로 감싸서 반환합니다.
+func NewProviderFromEnv() (LLMProvider, error) {
+	provider, err := newBaseProviderFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewResilientProvider(provider, ResilienceConfigFromEnv()), nil
+}
+
+func newBaseProviderFromEnv() (LLMProvider, error) {
+	switch provider := os.Getenv("LLM_PROVIDER"); provider {
+	case "", "openai":
+		apiKey := os.Getenv("OPENAI_API_KEY")
+		if apiKey == "" {
+			return nil, ErrMissingAPIKey
+		}
+		models := modelsFromEnv(Models{Chat: "gpt-4o-mini", Analysis: "gpt-4o-mini"})
+		return NewOpenAIProvider("openai", apiKey, os.Getenv("OPENAI_BASE_URL"), models), nil
+	case "anthropic":
+		apiKey := os.Getenv("ANTHROPIC_API_KEY")
+		if apiKey == "" {
+			return nil, ErrMissingAPIKey
+		}
+		models := modelsFromEnv(Models{Chat: "claude-sonnet-4-5", Analysis: "claude-haiku-4-5"})
+		return NewAnthropicProvider(apiKey, os.Getenv("ANTHROPIC_BASE_URL"), models), nil
+	case "ollama":
+		baseURL := os.Getenv("OLLAMA_BASE_URL")
+		if baseURL == "" {
+			baseURL = "http://localhost:11434/v1"
+		}
+		models := modelsFromEnv(Models{Chat: "llama3.1", Analysis: "llama3.1"})
+		return NewOpenAIProvider("ollama", os.Getenv("OLLAMA_API_KEY"), baseURL, models), nil
+	case "fake":
+		return NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("unsupported LLM provider: %s", provider)
+	}
+}
+
+// modelsFromEnv는 환경 변수에 지정된 작업별 모델로 기본값을 덮어씁니다
+func modelsFromEnv(defaults Models) Models {
+	if model := os.Getenv("LLM_CHAT_MODEL"); model != "" {
+		defaults.Chat = model
+	}
+	if model := os.Getenv("LLM_ANALYSIS_MODEL"); model != "" {
+		defaults.Analysis = model
+	}
+	return defaults
+}
@@ -0,0 +1,90 @@
+package llm
+
+import (
+	"context"
+	"strings"
+)
+
+// UsageRecorder는 성공한 제공자 호출 한 번의 사용량을 기록하는 함수입니다.
+// 누구의 어떤 요청인지는 호출한 쪽에서 ctx에 담아 전달합니다.
+type UsageRecorder func(ctx context.Context, task Task, provider string, response *Response)
+
+// MeteredProvider는 성공한 호출마다 응답의 토큰 사용량을 UsageRecorder로 기록합니다
+type MeteredProvider struct {
+	provider LLMProvider
+	record   UsageRecorder
+}
+
+// NewMeteredProvider는 provider를 감싼 새로운 MeteredProvider를 생성합니다
+func NewMeteredProvider(provider LLMProvider, record UsageRecorder) *MeteredProvider {
+	return &MeteredProvider{provider: provider, record: record}
+}
+
+// Name은 제공자 이름을 반환합니다
+func (p *MeteredProvider) Name() string {
+	return p.provider.Name()
+}
+
+// Model은 작업에 사용하는 모델 이름을 반환합니다
+func (p *MeteredProvider) Model(task Task) string {
+	return p.provider.Model(task)
+}
+
+// Complete는 응답 전체를 한 번에 받고 사용량을 기록합니다
+func (p *MeteredProvider) Complete(ctx context.Context, request Request) (*Response, error) {
+	response, err := p.provider.Complete(ctx, request)
+	return p.recorded(ctx, request, response, err)
+}
+
+// CompleteStructured는 schema를 따르는 JSON 응답을 받고 사용량을 기록합니다
+func (p *MeteredProvider) CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error) {
+	response, err := p.provider.CompleteStructured(ctx, request, schema)
+	return p.recorded(ctx, request, response, err)
+}
+
+// Stream은 응답을 조각 단위로 onChunk에 전달하고, 스트리밍이 끝나면 사용량을 기록합니다.
+// 조각을 보낸 뒤 실패하면 제공자도 과금하므로, 요청과 전달한 조각으로 어림한 사용량을 기록합니다.
+func (p *MeteredProvider) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error) {
+	var delivered strings.Builder
+	response, err := p.provider.Stream(ctx, request, func(chunk string) error {
+		delivered.WriteString(chunk)
+		return onChunk(chunk)
+	})
+	if err != nil && delivered.Len() > 0 {
+		p.record(ctx, request.Task, p.Name(), &Response{
+			Content: delivered.String(),
+			Model:   p.Model(request.Task),
+			Usage: Usage{
+				InputTokens:  EstimateRequestTokens(request),
+				OutputTokens: EstimateTokens(delivered.String()),
+				Estimated:    true,
+			},
+		})
+	}
+	return p.recorded(ctx, request, response, err)
+}
+
+func (p *MeteredProvider) recorded(ctx context.Context, request Request, response *Response, err error) (*Response, error) {
+	if err != nil {
+		return nil, err
+	}
+	p.record(ctx, request.Task, p.Name(), response)
+	return response, nil
+}
+
+// EstimateTokens는 토크나이저 없이 text의 토큰 수를 어림합니다 (UTF-8 4바이트당 1토큰, 한글은 글자당 약 0.75토큰)
+func EstimateTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	return (len(text) + 3) / 4
+}
+
+// EstimateRequestTokens는 요청 메시지 전체의 입력 토큰 수를 어림합니다
+func EstimateRequestTokens(request Request) int {
+	tokens := 0
+	for _, message := range request.Messages {
+		tokens += EstimateTokens(message.Content)
+	}
+	return tokens
+}
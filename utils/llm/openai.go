@@ -0,0 +1,149 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// OpenAIProvider는 OpenAI API 및 OpenAI 호환 서버(Ollama 등)를 사용하는 제공자입니다
+type OpenAIProvider struct {
+	name   string
+	client *openai.Client
+	models Models
+}
+
+// NewOpenAIProvider는 새로운 OpenAIProvider를 생성합니다. baseURL이 비어 있으면 OpenAI API를 사용합니다
+func NewOpenAIProvider(name, apiKey, baseURL string, models Models) *OpenAIProvider {
+	config := openai.DefaultConfig(apiKey)
+	config.HTTPClient = retryAfterDoer{client: &http.Client{}}
+	if baseURL != "" {
+		config.BaseURL = baseURL
+	}
+
+	return &OpenAIProvider{
+		name:   name,
+		client: openai.NewClientWithConfig(config),
+		models: models,
+	}
+}
+
+// Name은 제공자 이름을 반환합니다
+func (p *OpenAIProvider) Name() string {
+	return p.name
+}
+
+// Model은 작업에 사용하는 모델 이름을 반환합니다
+func (p *OpenAIProvider) Model(task Task) string {
+	return p.models.For(task)
+}
+
+// Complete는 응답 전체를 한 번에 받습니다
+func (p *OpenAIProvider) Complete(ctx context.Context, request Request) (*Response, error) {
+	return p.complete(ctx, p.chatCompletionRequest(request))
+}
+
+// CompleteStructured는 JSON 스키마 응답 형식을 지정하여 schema를 따르는 JSON 응답을 받습니다
+func (p *OpenAIProvider) CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error) {
+	chatRequest := p.chatCompletionRequest(request)
+	chatRequest.ResponseFormat = &openai.ChatCompletionResponseFormat{
+		Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+		JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+			Name:        schema.Name,
+			Description: schema.Description,
+			Schema:      schema.Definition,
+			Strict:      true,
+		},
+	}
+	return p.complete(ctx, chatRequest)
+}
+
+// Stream은 응답을 조각 단위로 onChunk에 전달합니다
+func (p *OpenAIProvider) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error) {
+	chatRequest := p.chatCompletionRequest(request)
+	chatRequest.Stream = true
+	chatRequest.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+
+	ctx, recorder := withRetryAfterRecorder(ctx)
+	stream, err := p.client.CreateChatCompletionStream(ctx, chatRequest)
+	if err != nil {
+		return nil, p.wrapError(err, recorder)
+	}
+	defer stream.Close()
+
+	response := &Response{Model: chatRequest.Model}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return response, nil
+		}
+		if err != nil {
+			return nil, p.wrapError(err, recorder)
+		}
+
+		if chunk.Usage != nil {
+			response.Usage = Usage{InputTokens: chunk.Usage.PromptTokens, OutputTokens: chunk.Usage.CompletionTokens}
+		}
+		if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+			content := chunk.Choices[0].Delta.Content
+			response.Content += content
+			if err := onChunk(content); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (p *OpenAIProvider) complete(ctx context.Context, chatRequest openai.ChatCompletionRequest) (*Response, error) {
+	ctx, recorder := withRetryAfterRecorder(ctx)
+	resp, err := p.client.CreateChatCompletion(ctx, chatRequest)
+	if err != nil {
+		return nil, p.wrapError(err, recorder)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response choices available")
+	}
+
+	return &Response{
+		Content: resp.Choices[0].Message.Content,
+		Model:   resp.Model,
+		Usage:   Usage{InputTokens: resp.Usage.PromptTokens, OutputTokens: resp.Usage.CompletionTokens},
+	}, nil
+}
+
+// wrapError는 상태 코드가 있는 API 에러를 *StatusError로 바꿉니다
+func (p *OpenAIProvider) wrapError(err error, recorder *retryAfterRecorder) error {
+	statusCode := 0
+	var apiErr *openai.APIError
+	var requestErr *openai.RequestError
+	if errors.As(err, &apiErr) {
+		statusCode = apiErr.HTTPStatusCode
+	} else if errors.As(err, &requestErr) {
+		statusCode = requestErr.HTTPStatusCode
+	}
+	if statusCode == 0 {
+		return err
+	}
+
+	return &StatusError{Provider: p.name, StatusCode: statusCode, RetryAfter: recorder.get(), Err: err}
+}
+
+func (p *OpenAIProvider) chatCompletionRequest(request Request) openai.ChatCompletionRequest {
+	messages := make([]openai.ChatCompletionMessage, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		messages = append(messages, openai.ChatCompletionMessage{
+			Role:    string(message.Role),
+			Content: message.Content,
+		})
+	}
+
+	return openai.ChatCompletionRequest{
+		Model:     p.models.For(request.Task),
+		Messages:  messages,
+		MaxTokens: request.MaxTokens,
+	}
+}
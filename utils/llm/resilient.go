@@ -0,0 +1,270 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	"career-log-be/utils/env"
+)
+
+// ResilienceConfig는 제공자 호출의 재시도, 제한 시간, 회로 차단기 설정입니다
+type ResilienceConfig struct {
+	MaxRetries       int           // 요청 한도 초과(429), 서버 오류(5xx), 네트워크 오류 시 최대 재시도 횟수
+	BaseDelay        time.Duration // 첫 재시도 대기 시간 (재시도마다 두 배, 지터 적용)
+	MaxDelay         time.Duration // 재시도 대기 시간 상한 (Retry-After가 더 길면 재시도하지 않음)
+	Timeout          time.Duration // 호출 한 번의 제한 시간
+	BreakerThreshold int           // 회로 차단기를 여는 연속 실패 횟수
+	BreakerCooldown  time.Duration // 회로 차단기가 열린 뒤 다시 시도하기까지의 시간
+}
+
+// ResilienceConfigFromEnv는 환경 변수에서 재시도 및 회로 차단기 설정을 읽습니다.
+// 재시도 횟수와 회로 차단기 실패 횟수만 0(사용하지 않음)을 허용하고, 시간 설정이 0 이하이면 기본값을 사용합니다.
+func ResilienceConfigFromEnv() ResilienceConfig {
+	return ResilienceConfig{
+		MaxRetries:       env.NonNegativeInt("LLM_MAX_RETRIES", 3),
+		BaseDelay:        time.Duration(env.Int("LLM_RETRY_BASE_DELAY_MS", 500)) * time.Millisecond,
+		MaxDelay:         time.Duration(env.Int("LLM_RETRY_MAX_DELAY_SECONDS", 20)) * time.Second,
+		Timeout:          time.Duration(env.Int("LLM_TIMEOUT_SECONDS", 60)) * time.Second,
+		BreakerThreshold: env.NonNegativeInt("LLM_BREAKER_FAILURES", 5),
+		BreakerCooldown:  time.Duration(env.Int("LLM_BREAKER_COOLDOWN_SECONDS", 30)) * time.Second,
+	}
+}
+
+// ResilientProvider는 제공자 호출에 제한 시간, 지터를 적용한 지수 백오프 재시도, 회로 차단기를 더합니다.
+// 일시적인 실패가 재시도 후에도 이어지거나 회로 차단기가 열려 있으면 *UnavailableError를 반환하고,
+// 재시도해도 성공할 수 없는 실패(잘못된 요청, 인증 실패, 응답 해석 실패 등)는 원래 에러를 반환합니다.
+type ResilientProvider struct {
+	provider LLMProvider
+	config   ResilienceConfig
+	breaker  *circuitBreaker
+}
+
+// NewResilientProvider는 provider를 감싼 새로운 ResilientProvider를 생성합니다
+func NewResilientProvider(provider LLMProvider, config ResilienceConfig) *ResilientProvider {
+	return &ResilientProvider{
+		provider: provider,
+		config:   config,
+		breaker:  &circuitBreaker{name: provider.Name(), threshold: config.BreakerThreshold, cooldown: config.BreakerCooldown},
+	}
+}
+
+// callbackError는 스트리밍 조각을 받는 쪽(onChunk)에서 발생한 에러입니다. 제공자 장애가 아니므로 재시도하지 않습니다
+type callbackError struct {
+	err error
+}
+
+func (e *callbackError) Error() string {
+	return e.err.Error()
+}
+
+// Name은 제공자 이름을 반환합니다
+func (p *ResilientProvider) Name() string {
+	return p.provider.Name()
+}
+
+// Model은 작업에 사용하는 모델 이름을 반환합니다
+func (p *ResilientProvider) Model(task Task) string {
+	return p.provider.Model(task)
+}
+
+// Complete는 응답 전체를 한 번에 받습니다
+func (p *ResilientProvider) Complete(ctx context.Context, request Request) (*Response, error) {
+	return p.call(ctx, func(ctx context.Context) (*Response, error) {
+		return p.provider.Complete(ctx, request)
+	}, nil)
+}
+
+// CompleteStructured는 schema를 따르는 JSON 응답을 받습니다
+func (p *ResilientProvider) CompleteStructured(ctx context.Context, request Request, schema Schema) (*Response, error) {
+	return p.call(ctx, func(ctx context.Context) (*Response, error) {
+		return p.provider.CompleteStructured(ctx, request, schema)
+	}, nil)
+}
+
+// Stream은 응답을 조각 단위로 onChunk에 전달합니다.
+// 이미 조각을 전달한 뒤 실패하면 응답이 중복되므로 재시도하지 않습니다.
+func (p *ResilientProvider) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (*Response, error) {
+	started := false
+	return p.call(ctx, func(ctx context.Context) (*Response, error) {
+		return p.provider.Stream(ctx, request, func(chunk string) error {
+			started = true
+			if err := onChunk(chunk); err != nil {
+				return &callbackError{err: err}
+			}
+			return nil
+		})
+	}, func() bool { return !started })
+}
+
+// call은 회로 차단기가 허용하면 attempt를 호출하고, 일시적인 실패는 백오프 후 재시도합니다
+func (p *ResilientProvider) call(ctx context.Context, attempt func(ctx context.Context) (*Response, error), canRetry func() bool) (*Response, error) {
+	for retry := 0; ; retry++ {
+		if wait, ok := p.breaker.allow(); !ok {
+			return nil, &UnavailableError{Provider: p.Name(), RetryAfter: wait, Err: ErrCircuitOpen}
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, p.config.Timeout)
+		response, err := attempt(attemptCtx)
+		cancel()
+		if err == nil {
+			p.breaker.success()
+			return response, nil
+		}
+
+		var callbackErr *callbackError
+		if errors.As(err, &callbackErr) {
+			p.breaker.release()
+			return nil, callbackErr.err
+		}
+		if ctx.Err() != nil {
+			p.breaker.release()
+			return nil, ctx.Err()
+		}
+
+		retryable, retryAfter := classify(err)
+		if !retryable {
+			// 제공자가 응답은 했으므로(잘못된 요청, 인증 실패 등) 장애로 보지 않고, 원래 에러를 그대로 반환합니다
+			p.breaker.success()
+			return nil, err
+		}
+		p.breaker.failure()
+
+		if retry >= p.config.MaxRetries || (canRetry != nil && !canRetry()) || retryAfter > p.config.MaxDelay {
+			return nil, &UnavailableError{Provider: p.Name(), RetryAfter: retryAfter, Err: err}
+		}
+
+		delay := p.backoff(retry, retryAfter)
+		log.Printf("LLM request to %s failed (retry %d/%d in %s): %v", p.Name(), retry+1, p.config.MaxRetries, delay, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff는 retry번째 재시도 전 대기 시간을 계산합니다.
+// BaseDelay * 2^retry (MaxDelay 상한)의 절반에 무작위 지터를 더하고, Retry-After가 더 길면 그 값을 따릅니다.
+func (p *ResilientProvider) backoff(retry int, retryAfter time.Duration) time.Duration {
+	delay := p.config.BaseDelay << retry
+	if delay <= 0 || delay > p.config.MaxDelay {
+		delay = p.config.MaxDelay
+	}
+	delay = delay/2 + rand.N(delay/2+1)
+
+	if retryAfter > delay {
+		return retryAfter
+	}
+	return delay
+}
+
+// classify는 에러가 재시도할 만한 일시적인 실패인지와 Retry-After 값을 반환합니다
+func classify(err error) (bool, time.Duration) {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Retryable(), statusErr.RetryAfter
+	}
+
+	// 호출 한 번의 제한 시간 초과, 네트워크 오류, 응답이 중간에 끊긴 경우
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true, 0
+	}
+	return false, 0
+}
+
+type circuitState int
+
+const (
+	circuitClosed   circuitState = iota
+	circuitOpen                  // 호출하지 않고 즉시 실패
+	circuitHalfOpen              // 대기 시간이 지나 한 번만 시험 호출
+)
+
+// circuitBreaker는 연속 실패가 threshold번 이어지면 cooldown 동안 호출을 막습니다.
+// cooldown이 지나면 한 번의 시험 호출을 허용하고, 성공하면 닫고 실패하면 다시 엽니다.
+type circuitBreaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	trial    bool // 시험 호출 진행 중 여부
+}
+
+// allow는 호출해도 되는지 반환합니다. 막혔다면 다시 시도할 수 있을 때까지 남은 시간을 함께 반환합니다
+func (b *circuitBreaker) allow() (time.Duration, bool) {
+	if b.threshold <= 0 {
+		return 0, true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if wait := time.Until(b.openedAt.Add(b.cooldown)); wait > 0 {
+			return wait, false
+		}
+		b.state = circuitHalfOpen
+		b.trial = true
+		return 0, true
+	case circuitHalfOpen:
+		if b.trial {
+			return b.cooldown, false
+		}
+		b.trial = true
+		return 0, true
+	default:
+		return 0, true
+	}
+}
+
+// success는 호출 성공을 기록하고 회로를 닫습니다
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != circuitClosed {
+		log.Printf("Circuit breaker for %s closed", b.name)
+	}
+	b.state = circuitClosed
+	b.failures = 0
+	b.trial = false
+}
+
+// failure는 호출 실패를 기록하고, 연속 실패가 threshold번이거나 시험 호출이 실패하면 회로를 엽니다
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == circuitHalfOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+		if b.state != circuitOpen {
+			log.Printf("Circuit breaker for %s opened after %d consecutive failures", b.name, b.failures)
+		}
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+		b.trial = false
+	}
+}
+
+// release는 결과를 판단할 수 없는 호출(취소 등)이 끝났을 때 시험 호출 기회를 되돌립니다
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+}
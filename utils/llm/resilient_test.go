@@ -0,0 +1,356 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	type step struct {
+		action      string // allow, failure, success, release, expire(쿨다운 경과)
+		wantAllowed bool   // allow 단계의 기대 결과
+		wantState   circuitState
+	}
+
+	tests := []struct {
+		name      string
+		threshold int
+		steps     []step
+	}{
+		{
+			name:      "opens after consecutive failures",
+			threshold: 2,
+			steps: []step{
+				{"allow", true, circuitClosed},
+				{"failure", false, circuitClosed},
+				{"allow", true, circuitClosed},
+				{"failure", false, circuitOpen},
+				{"allow", false, circuitOpen},
+			},
+		},
+		{
+			name:      "success resets the failure count",
+			threshold: 2,
+			steps: []step{
+				{"failure", false, circuitClosed},
+				{"success", false, circuitClosed},
+				{"failure", false, circuitClosed},
+				{"allow", true, circuitClosed},
+			},
+		},
+		{
+			name:      "half-open allows a single trial that closes on success",
+			threshold: 1,
+			steps: []step{
+				{"failure", false, circuitOpen},
+				{"expire", false, circuitOpen},
+				{"allow", true, circuitHalfOpen},
+				{"allow", false, circuitHalfOpen},
+				{"success", false, circuitClosed},
+				{"allow", true, circuitClosed},
+			},
+		},
+		{
+			name:      "failed trial reopens the circuit",
+			threshold: 3,
+			steps: []step{
+				{"failure", false, circuitClosed},
+				{"failure", false, circuitClosed},
+				{"failure", false, circuitOpen},
+				{"expire", false, circuitOpen},
+				{"allow", true, circuitHalfOpen},
+				{"failure", false, circuitOpen},
+				{"allow", false, circuitOpen},
+			},
+		},
+		{
+			name:      "released trial can be retried",
+			threshold: 1,
+			steps: []step{
+				{"failure", false, circuitOpen},
+				{"expire", false, circuitOpen},
+				{"allow", true, circuitHalfOpen},
+				{"release", false, circuitHalfOpen},
+				{"allow", true, circuitHalfOpen},
+			},
+		},
+		{
+			name:      "zero threshold disables the breaker",
+			threshold: 0,
+			steps: []step{
+				{"failure", false, circuitClosed},
+				{"failure", false, circuitClosed},
+				{"allow", true, circuitClosed},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breaker := &circuitBreaker{name: "test", threshold: tt.threshold, cooldown: time.Hour}
+			for i, s := range tt.steps {
+				switch s.action {
+				case "allow":
+					wait, allowed := breaker.allow()
+					if allowed != s.wantAllowed {
+						t.Fatalf("step %d: allow() = %v, want %v", i, allowed, s.wantAllowed)
+					}
+					if !allowed && wait <= 0 {
+						t.Fatalf("step %d: allow() wait = %v, want positive", i, wait)
+					}
+				case "failure":
+					breaker.failure()
+				case "success":
+					breaker.success()
+				case "release":
+					breaker.release()
+				case "expire":
+					breaker.openedAt = time.Now().Add(-breaker.cooldown - time.Second)
+				}
+				if breaker.state != s.wantState {
+					t.Fatalf("step %d (%s): state = %v, want %v", i, s.action, breaker.state, s.wantState)
+				}
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		base       time.Duration
+		max        time.Duration
+		retry      int
+		retryAfter time.Duration
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{"first retry", 100 * time.Millisecond, time.Second, 0, 0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{"doubles each retry", 100 * time.Millisecond, time.Second, 2, 0, 200 * time.Millisecond, 400 * time.Millisecond},
+		{"capped at max delay", 100 * time.Millisecond, time.Second, 5, 0, 500 * time.Millisecond, time.Second},
+		{"shift overflow falls back to max delay", 100 * time.Millisecond, time.Second, 70, 0, 500 * time.Millisecond, time.Second},
+		{"longer retry-after wins", 100 * time.Millisecond, time.Second, 0, 800 * time.Millisecond, 800 * time.Millisecond, 800 * time.Millisecond},
+		{"shorter retry-after is ignored", 100 * time.Millisecond, time.Second, 3, time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &ResilientProvider{config: ResilienceConfig{BaseDelay: tt.base, MaxDelay: tt.max}}
+			// 지터가 무작위이므로 여러 번 계산해 범위를 확인합니다
+			for i := 0; i < 200; i++ {
+				delay := provider.backoff(tt.retry, tt.retryAfter)
+				if delay < tt.wantMin || delay > tt.wantMax {
+					t.Fatalf("backoff(%d, %v) = %v, want between %v and %v", tt.retry, tt.retryAfter, delay, tt.wantMin, tt.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantRetryable  bool
+		wantRetryAfter time.Duration
+	}{
+		{"rate limited", &StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 3 * time.Second}, true, 3 * time.Second},
+		{"request timeout", &StatusError{StatusCode: http.StatusRequestTimeout}, true, 0},
+		{"server error", &StatusError{StatusCode: http.StatusBadGateway}, true, 0},
+		{"overloaded", &StatusError{StatusCode: 529}, true, 0},
+		{"bad request", &StatusError{StatusCode: http.StatusBadRequest}, false, 0},
+		{"unauthorized", &StatusError{StatusCode: http.StatusUnauthorized}, false, 0},
+		{"wrapped status error", fmt.Errorf("call failed: %w", &StatusError{StatusCode: http.StatusServiceUnavailable}), true, 0},
+		{"deadline exceeded", context.DeadlineExceeded, true, 0},
+		{"network error", &net.DNSError{Err: "no such host", Name: "api.example.com"}, true, 0},
+		{"truncated response", io.ErrUnexpectedEOF, true, 0},
+		{"decode failure", errors.New("failed to decode response"), false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryable, retryAfter := classify(tt.err)
+			if retryable != tt.wantRetryable || retryAfter != tt.wantRetryAfter {
+				t.Errorf("classify() = (%v, %v), want (%v, %v)", retryable, retryAfter, tt.wantRetryable, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestResilientProviderComplete(t *testing.T) {
+	unavailableErr := &StatusError{Provider: "fake", StatusCode: http.StatusServiceUnavailable, Err: errors.New("overloaded")}
+	badRequestErr := &StatusError{Provider: "fake", StatusCode: http.StatusBadRequest, Err: errors.New("invalid request")}
+
+	tests := []struct {
+		name             string
+		replies          []FakeReply
+		maxRetries       int
+		wantRequests     int
+		wantUnavailable  bool
+		wantCircuitOpen  bool
+		wantErr          error // 그대로 반환되어야 하는 에러 (UnavailableError가 아닌 경우)
+		wantContent      string
+		breakerThreshold int
+	}{
+		{
+			name:         "retries transient failures",
+			replies:      []FakeReply{{Err: unavailableErr}, {Err: unavailableErr}, {Content: "ok"}},
+			maxRetries:   3,
+			wantRequests: 3,
+			wantContent:  "ok",
+		},
+		{
+			name:            "gives up after max retries",
+			replies:         []FakeReply{{Err: unavailableErr}, {Err: unavailableErr}, {Err: unavailableErr}},
+			maxRetries:      2,
+			wantRequests:    3,
+			wantUnavailable: true,
+		},
+		{
+			name:         "returns non-retryable errors as is",
+			replies:      []FakeReply{{Err: badRequestErr}, {Content: "ok"}},
+			maxRetries:   3,
+			wantRequests: 1,
+			wantErr:      badRequestErr,
+		},
+		{
+			name:            "does not wait for retry-after longer than max delay",
+			replies:         []FakeReply{{Err: &StatusError{Provider: "fake", StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute}}},
+			maxRetries:      3,
+			wantRequests:    1,
+			wantUnavailable: true,
+		},
+		{
+			name:             "stops calling once the circuit opens",
+			replies:          []FakeReply{{Err: unavailableErr}, {Err: unavailableErr}, {Content: "ok"}},
+			maxRetries:       3,
+			breakerThreshold: 2,
+			wantRequests:     2,
+			wantUnavailable:  true,
+			wantCircuitOpen:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeProvider()
+			fake.Script(ChatTask, tt.replies...)
+			provider := NewResilientProvider(fake, ResilienceConfig{
+				MaxRetries:       tt.maxRetries,
+				BaseDelay:        time.Millisecond,
+				MaxDelay:         5 * time.Millisecond,
+				Timeout:          time.Second,
+				BreakerThreshold: tt.breakerThreshold,
+				BreakerCooldown:  time.Hour,
+			})
+
+			response, err := provider.Complete(context.Background(), Request{Task: ChatTask})
+			if got := len(fake.Requests()); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+
+			var unavailable *UnavailableError
+			switch {
+			case tt.wantUnavailable:
+				if !errors.As(err, &unavailable) {
+					t.Fatalf("error = %v, want *UnavailableError", err)
+				}
+				if errors.Is(err, ErrCircuitOpen) != tt.wantCircuitOpen {
+					t.Errorf("errors.Is(err, ErrCircuitOpen) = %v, want %v", !tt.wantCircuitOpen, tt.wantCircuitOpen)
+				}
+			case tt.wantErr != nil:
+				if err != tt.wantErr {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				if errors.As(err, &unavailable) {
+					t.Errorf("non-retryable error must not be reported as *UnavailableError")
+				}
+			default:
+				if err != nil {
+					t.Fatalf("error = %v, want nil", err)
+				}
+				if response.Content != tt.wantContent {
+					t.Errorf("content = %q, want %q", response.Content, tt.wantContent)
+				}
+			}
+		})
+	}
+}
+
+func TestResilientProviderStream(t *testing.T) {
+	unavailableErr := &StatusError{Provider: "fake", StatusCode: http.StatusServiceUnavailable, Err: errors.New("overloaded")}
+
+	tests := []struct {
+		name         string
+		replies      []FakeReply
+		wantRequests int
+		wantChunks   int
+		wantErr      bool
+	}{
+		{
+			name:         "retries before any chunk is sent",
+			replies:      []FakeReply{{Err: unavailableErr}, {Chunks: []string{"a", "b"}}},
+			wantRequests: 2,
+			wantChunks:   2,
+		},
+		{
+			name:         "does not retry after chunks were sent",
+			replies:      []FakeReply{{Chunks: []string{"a"}, Err: unavailableErr}, {Chunks: []string{"a", "b"}}},
+			wantRequests: 1,
+			wantChunks:   1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeProvider()
+			fake.Script(ChatTask, tt.replies...)
+			provider := NewResilientProvider(fake, ResilienceConfig{
+				MaxRetries: 3,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   5 * time.Millisecond,
+				Timeout:    time.Second,
+			})
+
+			chunks := 0
+			_, err := provider.Stream(context.Background(), Request{Task: ChatTask}, func(string) error {
+				chunks++
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if got := len(fake.Requests()); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if chunks != tt.wantChunks {
+				t.Errorf("chunks = %d, want %d", chunks, tt.wantChunks)
+			}
+		})
+	}
+}
+
+func TestResilientProviderCallbackError(t *testing.T) {
+	fake := NewFakeProvider()
+	fake.Script(ChatTask, FakeReply{Chunks: []string{"a", "b"}})
+	provider := NewResilientProvider(fake, ResilienceConfig{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond, Timeout: time.Second, BreakerThreshold: 1, BreakerCooldown: time.Hour})
+
+	clientGone := errors.New("client disconnected")
+	_, err := provider.Stream(context.Background(), Request{Task: ChatTask}, func(string) error {
+		return clientGone
+	})
+	if err != clientGone {
+		t.Fatalf("error = %v, want %v", err, clientGone)
+	}
+	// 받는 쪽의 에러는 제공자 장애가 아니므로 회로 차단기를 열지 않습니다
+	if _, allowed := provider.breaker.allow(); !allowed {
+		t.Error("callback error must not open the circuit breaker")
+	}
+}
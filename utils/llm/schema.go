@@ -0,0 +1,136 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// jsonSchema는 구조화된 출력 검증에 사용하는 JSON 스키마의 부분 집합입니다.
+// type, properties, required, additionalProperties(false), items, enum, minimum, maximum을 지원합니다.
+type jsonSchema struct {
+	Type                 string                 `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Enum                 []interface{}          `json:"enum"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+}
+
+// Validate는 JSON 문서 data가 스키마를 따르는지 검사합니다
+func (s Schema) Validate(data []byte) error {
+	var schema jsonSchema
+	if err := json.Unmarshal(s.Definition, &schema); err != nil {
+		return fmt.Errorf("invalid schema %s: %v", s.Name, err)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	return schema.validate("$", value)
+}
+
+func (s *jsonSchema) validate(path string, value interface{}) error {
+	if len(s.Enum) > 0 && !containsValue(s.Enum, value) {
+		return fmt.Errorf("%s: value %v is not allowed", path, value)
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object", path)
+		}
+		return s.validateObject(path, object)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected array", path)
+		}
+		if s.Items != nil {
+			for i, item := range array {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case "number", "integer":
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("%s: expected %s", path, s.Type)
+		}
+		if s.Type == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("%s: expected integer", path)
+		}
+		if s.Minimum != nil && number < *s.Minimum {
+			return fmt.Errorf("%s: %v is less than minimum %v", path, number, *s.Minimum)
+		}
+		if s.Maximum != nil && number > *s.Maximum {
+			return fmt.Errorf("%s: %v is greater than maximum %v", path, number, *s.Maximum)
+		}
+		return nil
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected string", path)
+		}
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean", path)
+		}
+		return nil
+	case "null":
+		if value != nil {
+			return fmt.Errorf("%s: expected null", path)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s: unsupported schema type %s", path, s.Type)
+	}
+}
+
+func (s *jsonSchema) validateObject(path string, object map[string]interface{}) error {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			return fmt.Errorf("%s: missing required property %s", path, name)
+		}
+	}
+
+	// 에러 메시지가 매번 같도록 속성 이름 순서로 검사합니다
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s: unexpected property %s", path, name)
+			}
+			continue
+		}
+		if err := property.validate(path+"."+name, object[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testSchema = Schema{
+	Name: "test",
+	Definition: json.RawMessage(`{
+		"type": "object",
+		"properties": {
+			"score": {"type": "integer", "minimum": -10, "maximum": 10},
+			"ratio": {"type": "number", "minimum": 0, "maximum": 1},
+			"label": {"type": "string", "enum": ["low", "high"]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"done": {"type": "boolean"},
+			"note": {"type": "null"}
+		},
+		"required": ["score"],
+		"additionalProperties": false
+	}`),
+}
+
+type testResult struct {
+	Score int `json:"score"`
+}
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string // 비어 있으면 통과해야 함
+	}{
+		{"minimal", `{"score": 3}`, ""},
+		{"all properties", `{"score": -10, "ratio": 0.5, "label": "high", "tags": ["a", "b"], "done": true, "note": null}`, ""},
+		{"maximum is inclusive", `{"score": 10, "ratio": 1}`, ""},
+		{"not json", `{"score": `, "invalid JSON"},
+		{"not an object", `[1, 2]`, "$: expected object"},
+		{"missing required", `{"ratio": 0.5}`, "$: missing required property score"},
+		{"unexpected property", `{"score": 1, "extra": 1}`, "$: unexpected property extra"},
+		{"below minimum", `{"score": -11}`, "$.score: -11 is less than minimum -10"},
+		{"above maximum", `{"score": 11}`, "$.score: 11 is greater than maximum 10"},
+		{"not an integer", `{"score": 1.5}`, "$.score: expected integer"},
+		{"string instead of number", `{"score": "3"}`, "$.score: expected integer"},
+		{"enum mismatch", `{"score": 1, "label": "medium"}`, "$.label: value medium is not allowed"},
+		{"array item type", `{"score": 1, "tags": ["a", 2]}`, "$.tags[1]: expected string"},
+		{"boolean type", `{"score": 1, "done": "yes"}`, "$.done: expected boolean"},
+		{"null type", `{"score": 1, "note": 0}`, "$.note: expected null"},
+		// 속성 이름 순서로 검사하므로 여러 속성이 틀려도 항상 같은 에러를 반환합니다
+		{"deterministic order", `{"score": 99, "ratio": 2}`, "$.ratio: 2 is greater than maximum 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testSchema.Validate([]byte(tt.data))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateInvalidDefinition(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantErr    string
+	}{
+		{"malformed definition", `{"type": `, "invalid schema"},
+		{"unsupported type", `{"type": "date"}`, "unsupported schema type date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := Schema{Name: "broken", Definition: json.RawMessage(tt.definition)}
+			err := schema.Validate([]byte(`{}`))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"plain object", `{"score": 1}`, `{"score": 1}`},
+		{"code fence", "```json\n{\"score\": 1}\n```", `{"score": 1}`},
+		{"surrounding text", `결과입니다: {"score": 1} 감사합니다`, `{"score": 1}`},
+		{"nested object", `{"a": {"b": 1}}`, `{"a": {"b": 1}}`},
+		{"no object", "  not json  ", "not json"},
+		{"unclosed object", `{"score": `, `{"score":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractJSON(tt.content); got != tt.want {
+				t.Errorf("extractJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteJSON(t *testing.T) {
+	positive := func(result *testResult) error {
+		if result.Score <= 0 {
+			return errors.New("score must be positive")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name         string
+		replies      []FakeReply
+		maxAttempts  int
+		wantScore    int
+		wantFailed   int
+		wantRequests int
+		wantErr      bool
+	}{
+		{
+			name:         "valid on first attempt",
+			replies:      []FakeReply{{Content: `{"score": 3}`}},
+			maxAttempts:  3,
+			wantScore:    3,
+			wantRequests: 1,
+		},
+		{
+			name:         "repairs malformed JSON",
+			replies:      []FakeReply{{Content: `{"score": `}, {Content: "```json\n{\"score\": 4}\n```"}},
+			maxAttempts:  3,
+			wantScore:    4,
+			wantFailed:   1,
+			wantRequests: 2,
+		},
+		{
+			name:         "repairs schema and validate failures",
+			replies:      []FakeReply{{Content: `{"score": 20}`}, {Content: `{"score": -1}`}, {Content: `{"score": 5}`}},
+			maxAttempts:  3,
+			wantScore:    5,
+			wantFailed:   2,
+			wantRequests: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			replies:      []FakeReply{{Content: `{}`}, {Content: `{}`}, {Content: `{"score": 5}`}},
+			maxAttempts:  2,
+			wantFailed:   2,
+			wantRequests: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "non-positive max attempts makes one attempt",
+			replies:      []FakeReply{{Content: `{}`}},
+			maxAttempts:  0,
+			wantFailed:   1,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewFakeProvider()
+			provider.Script(AnalysisTask, tt.replies...)
+			request := Request{Task: AnalysisTask, Messages: []Message{{Role: UserRole, Content: "analyze"}}}
+
+			result, _, failed, err := CompleteJSON(context.Background(), provider, request, testSchema, tt.maxAttempts, positive)
+			if len(failed) != tt.wantFailed {
+				t.Errorf("failed attempts = %d, want %d", len(failed), tt.wantFailed)
+			}
+			if got := len(provider.Requests()); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if tt.wantErr {
+				var structuredErr *StructuredOutputError
+				if !errors.As(err, &structuredErr) {
+					t.Fatalf("error = %v, want *StructuredOutputError", err)
+				}
+				if len(structuredErr.Attempts) != tt.wantFailed || structuredErr.Error() == "" {
+					t.Errorf("StructuredOutputError attempts = %d, want %d", len(structuredErr.Attempts), tt.wantFailed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v, want nil", err)
+			}
+			if result.Score != tt.wantScore {
+				t.Errorf("score = %d, want %d", result.Score, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestCompleteJSONSendsRepairPrompt(t *testing.T) {
+	provider := NewFakeProvider()
+	provider.Script(AnalysisTask, FakeReply{Content: `{"score": 11}`}, FakeReply{Content: `{"score": 1}`})
+	request := Request{Task: AnalysisTask, Messages: []Message{{Role: UserRole, Content: "analyze"}}}
+
+	if _, _, _, err := CompleteJSON[testResult](context.Background(), provider, request, testSchema, 2, nil); err != nil {
+		t.Fatalf("CompleteJSON error = %v", err)
+	}
+
+	requests := provider.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("requests = %d, want 2", len(requests))
+	}
+	if len(requests[0].Messages) != 1 {
+		t.Errorf("first request messages = %d, want 1 (caller's messages must not be modified)", len(requests[0].Messages))
+	}
+
+	repair := requests[1].Messages
+	if len(repair) != 3 {
+		t.Fatalf("repair request messages = %d, want 3", len(repair))
+	}
+	if repair[1].Role != AssistantRole || repair[1].Content != `{"score": 11}` {
+		t.Errorf("repair request should include the previous response, got %+v", repair[1])
+	}
+	if repair[2].Role != UserRole || !strings.Contains(repair[2].Content, "greater than maximum 10") {
+		t.Errorf("repair prompt should explain the failure, got %q", repair[2].Content)
+	}
+}
@@ -0,0 +1,92 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Attempt는 구조화된 출력 요청 한 번의 원본 응답과 실패 사유입니다
+type Attempt struct {
+	Raw   string
+	Error string
+}
+
+// StructuredOutputError는 재시도 후에도 올바른 구조화된 출력을 받지 못했을 때의 에러입니다
+type StructuredOutputError struct {
+	Attempts []Attempt
+}
+
+func (e *StructuredOutputError) Error() string {
+	last := e.Attempts[len(e.Attempts)-1]
+	return fmt.Sprintf("invalid structured output after %d attempts: %s", len(e.Attempts), last.Error)
+}
+
+// CompleteJSON은 schema를 따르는 JSON 응답을 받아 T로 디코딩합니다.
+// 응답이 JSON이 아니거나, 스키마 또는 validate 검사에 실패하면 실패 사유를 알려주는 복구 요청으로
+// 최대 maxAttempts번까지 다시 요청합니다 (1보다 작으면 한 번만 요청). 제공자 호출 자체가 실패하면 재시도하지 않고 에러를 반환합니다.
+// 마지막 응답과 함께 실패한 시도들을 반환하며, 모두 실패하면 *StructuredOutputError를 반환합니다.
+func CompleteJSON[T any](ctx context.Context, provider LLMProvider, request Request, schema Schema, maxAttempts int, validate func(*T) error) (*T, *Response, []Attempt, error) {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	messages := append([]Message(nil), request.Messages...)
+
+	var failed []Attempt
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		request.Messages = messages
+		response, err := provider.CompleteStructured(ctx, request, schema)
+		if err != nil {
+			return nil, nil, failed, err
+		}
+
+		result, err := decodeJSON[T](response.Content, schema, validate)
+		if err == nil {
+			return result, response, failed, nil
+		}
+
+		failed = append(failed, Attempt{Raw: response.Content, Error: err.Error()})
+		messages = append(messages,
+			Message{Role: AssistantRole, Content: response.Content},
+			Message{Role: UserRole, Content: repairPrompt(err)},
+		)
+	}
+
+	return nil, nil, failed, &StructuredOutputError{Attempts: failed}
+}
+
+// decodeJSON은 응답에서 JSON 객체를 꺼내 스키마와 validate로 검사한 뒤 T로 디코딩합니다
+func decodeJSON[T any](content string, schema Schema, validate func(*T) error) (*T, error) {
+	data := []byte(extractJSON(content))
+	if err := schema.Validate(data); err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	if validate != nil {
+		if err := validate(&result); err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
+}
+
+// extractJSON은 코드 펜스나 앞뒤 설명이 붙은 응답에서 JSON 객체 부분만 꺼냅니다
+func extractJSON(content string) string {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return strings.TrimSpace(content)
+	}
+	return content[start : end+1]
+}
+
+// repairPrompt는 이전 응답의 문제를 알려주고 올바른 JSON만 다시 요청하는 메시지입니다
+func repairPrompt(err error) string {
+	return fmt.Sprintf("이전 응답을 처리하지 못했습니다: %v\n설명이나 코드 블록 없이, 주어진 스키마를 따르는 JSON 객체 하나만 다시 응답해주세요.", err)
+}
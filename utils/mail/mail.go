@@ -0,0 +1,40 @@
+// Package mail provides pluggable email senders
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Message는 발송할 이메일입니다
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender는 이메일 발송 구현체의 인터페이스입니다
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewSenderFromEnv는 MAIL_DRIVER 환경 변수에 따라 Sender를 생성합니다.
+// smtp: SMTP 서버로 발송, log(기본값): 로그에 기록하고 메모리에 보관
+func NewSenderFromEnv() (Sender, error) {
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "smtp":
+		return NewSMTPSender(SMTPConfigFromEnv()), nil
+	case "", "log":
+		return NewMemorySender(), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", driver)
+	}
+}
+
+// NormalizeAddress는 이메일 주소의 앞뒤 공백을 제거하고 소문자로 바꿉니다.
+// 가입, 로그인, 외부 계정 연결 등에서 같은 주소를 하나의 계정으로 다루기 위해 저장·조회 전에 사용합니다.
+func NormalizeAddress(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// MemorySender는 이메일을 실제로 발송하지 않고 로그에 기록한 뒤 메모리에 보관합니다.
+// 로컬 개발 및 테스트용입니다.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewMemorySender는 새로운 MemorySender를 생성합니다
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send는 Sender 인터페이스를 구현합니다
+func (s *MemorySender) Send(ctx context.Context, message Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, message)
+	log.Printf("[mail] to=%s subject=%q\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// Messages는 지금까지 보관된 이메일 목록을 반환합니다
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]Message, len(s.messages))
+	copy(messages, s.messages)
+	return messages
+}
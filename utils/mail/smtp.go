@@ -0,0 +1,79 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+)
+
+// SMTPConfig는 SMTP 발송 설정입니다
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPConfigFromEnv는 환경 변수에서 SMTP 설정을 읽습니다
+func SMTPConfigFromEnv() SMTPConfig {
+	config := SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+	}
+	if config.Host == "" {
+		config.Host = "localhost"
+	}
+	if config.Port == "" {
+		config.Port = "1025" // MailHog 기본 포트
+	}
+	if config.From == "" {
+		config.From = "no-reply@career-log.local"
+	}
+	return config
+}
+
+// SMTPSender는 SMTP 서버를 통해 이메일을 발송합니다
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+// NewSMTPSender는 새로운 SMTPSender를 생성합니다
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	return &SMTPSender{config: config}
+}
+
+// Send는 Sender 인터페이스를 구현합니다
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	headers := []string{
+		"From: " + s.config.From,
+		"To: " + message.To,
+		"Subject: " + mime.BEncoding.Encode("UTF-8", message.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	body := strings.Join(headers, "\r\n") + "\r\n\r\n" + message.Body
+
+	addr := net.JoinHostPort(s.config.Host, s.config.Port)
+	if err := smtp.SendMail(addr, auth, s.config.From, []string{message.To}, []byte(body)); err != nil {
+		return fmt.Errorf("failed to send mail via SMTP: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Package ratelimit provides a token bucket rate limiter
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenBucket은 초당 rate개씩 토큰이 채워지고 최대 burst개까지 쌓이는 토큰 버킷입니다
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket은 분당 perMinute개의 요청을 허용하는 토큰 버킷을 생성합니다. 처음에는 burst개의 토큰이 채워져 있습니다
+func NewTokenBucket(perMinute int, burst int) *TokenBucket {
+	if perMinute < 1 {
+		perMinute = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   float64(perMinute) / 60,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait는 토큰을 하나 얻을 때까지 기다립니다. ctx가 취소되면 ctx의 에러를 반환합니다
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		wait := b.reserve()
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve는 토큰이 있으면 하나를 꺼내고 0을, 없으면 다음 토큰이 채워질 때까지의 시간을 반환합니다
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,55 @@
+package timezone
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // 시스템에 tzdata가 없는 컨테이너에서도 시간대를 불러올 수 있도록 내장합니다
+)
+
+// DefaultName은 시간대를 설정하지 않은 사용자에게 적용되는 기본 시간대입니다
+const DefaultName = "Asia/Seoul"
+
+// Load는 IANA 시간대 이름(예: "America/New_York")으로 Location을 반환합니다
+func Load(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultName
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %v", name, err)
+	}
+	return loc, nil
+}
+
+// LoadOrDefault는 시간대를 불러오고, 실패하면 기본 시간대를 반환합니다
+func LoadOrDefault(name string) *time.Location {
+	if loc, err := Load(name); err == nil {
+		return loc
+	}
+	if loc, err := time.LoadLocation(DefaultName); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// IsValid는 IANA 시간대 이름인지 확인합니다
+func IsValid(name string) bool {
+	_, err := Load(name)
+	return err == nil
+}
+
+// DayBounds는 주어진 시각이 속한 loc 기준 하루의 시작과 끝(다음 날 자정)을 UTC로 반환합니다
+func DayBounds(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	local := t.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 0, 1)
+	return start.UTC(), end.UTC()
+}
+
+// PreviousDayBounds는 주어진 시각 기준 loc의 전날 하루 범위를 UTC로 반환합니다
+func PreviousDayBounds(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	start, _ := DayBounds(t, loc)
+	local := start.In(loc)
+	previousStart := time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, loc)
+	return previousStart.UTC(), start
+}
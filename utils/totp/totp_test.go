@@ -0,0 +1,121 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret은 RFC 6238 부록 B의 SHA1 테스트 키("12345678901234567890")를 base32로 인코딩한 값입니다
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// RFC 6238 부록 B의 8자리 코드 중 뒤 6자리 (같은 HOTP 값을 10^6으로 나눈 나머지)
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code(%d) error = %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeNormalizesSecret(t *testing.T) {
+	want, err := Code(rfcSecret, 1)
+	if err != nil {
+		t.Fatalf("Code error = %v", err)
+	}
+
+	got, err := Code("  "+strings.ToLower(rfcSecret)+"\n", 1)
+	if err != nil {
+		t.Fatalf("Code with lowercase secret error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Code with lowercase secret = %s, want %s", got, want)
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Error("Code with invalid secret error = nil, want error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	codeAt := func(step int64) string {
+		code, err := Code(rfcSecret, step)
+		if err != nil {
+			t.Fatalf("Code(%d) error = %v", step, err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		skew     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{"current step", rfcSecret, codeAt(current), 1, current, true},
+		{"previous step within skew", rfcSecret, codeAt(current - 1), 1, current - 1, true},
+		{"next step within skew", rfcSecret, codeAt(current + 1), 1, current + 1, true},
+		{"two steps behind with skew 1", rfcSecret, codeAt(current - 2), 1, 0, false},
+		{"two steps ahead with skew 1", rfcSecret, codeAt(current + 2), 1, 0, false},
+		{"two steps behind with skew 2", rfcSecret, codeAt(current - 2), 2, current - 2, true},
+		{"previous step without skew", rfcSecret, codeAt(current - 1), 0, 0, false},
+		{"surrounding whitespace", rfcSecret, " " + codeAt(current) + "\n", 0, current, true},
+		{"too short", rfcSecret, codeAt(current)[:Digits-1], 1, 0, false},
+		{"too long", rfcSecret, codeAt(current) + "0", 1, 0, false},
+		{"invalid secret", "not base32!", "000000", 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(tt.secret, tt.code, now, tt.skew)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate() = (%d, %v), want (%d, %v)", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret error = %v", err)
+	}
+
+	key, err := encoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("GenerateSecret returned invalid base32 %q: %v", secret, err)
+	}
+	if len(key) != secretBytes {
+		t.Errorf("secret length = %d bytes, want %d", len(key), secretBytes)
+	}
+}
+
+func TestURI(t *testing.T) {
+	got := URI("Career Log", "user@example.com", rfcSecret)
+	want := "otpauth://totp/Career%20Log:user@example.com?algorithm=SHA1&digits=6&issuer=Career%20Log&period=30&secret=" + rfcSecret
+	if got != want {
+		t.Errorf("URI() = %s, want %s", got, want)
+	}
+}